@@ -0,0 +1,164 @@
+package chrono
+
+// Adjuster is a function that adjusts a date, such as by moving it to the last day of its month.
+// Adjusters are applied using the With methods of LocalDate, LocalDateTime and OffsetDateTime,
+// and can be composed by applying them in turn, for example:
+//
+//	d.With(FirstDayOfNextMonth()).With(FirstInMonth(Monday))
+//
+// The adjusters provided by this package panic if the resulting date cannot be represented,
+// in the same manner as LocalDate.AddDate.
+type Adjuster func(LocalDate) LocalDate
+
+// FirstDayOfMonth returns an Adjuster that returns the first day of the date's month.
+func FirstDayOfMonth() Adjuster {
+	return func(d LocalDate) LocalDate {
+		return firstDayOfUnit(d, UnitMonth)
+	}
+}
+
+// LastDayOfMonth returns an Adjuster that returns the last day of the date's month.
+func LastDayOfMonth() Adjuster {
+	return func(d LocalDate) LocalDate {
+		return firstDayOfNextUnit(d, UnitMonth) - 1
+	}
+}
+
+// FirstDayOfNextMonth returns an Adjuster that returns the first day of the month after the date's month.
+func FirstDayOfNextMonth() Adjuster {
+	return func(d LocalDate) LocalDate {
+		return firstDayOfNextUnit(d, UnitMonth)
+	}
+}
+
+// FirstDayOfQuarter returns an Adjuster that returns the first day of the date's quarter.
+// Quarters start in January, April, July and October.
+func FirstDayOfQuarter() Adjuster {
+	return func(d LocalDate) LocalDate {
+		return firstDayOfUnit(d, UnitQuarter)
+	}
+}
+
+// LastDayOfQuarter returns an Adjuster that returns the last day of the date's quarter.
+func LastDayOfQuarter() Adjuster {
+	return func(d LocalDate) LocalDate {
+		return firstDayOfNextUnit(d, UnitQuarter) - 1
+	}
+}
+
+// FirstDayOfNextQuarter returns an Adjuster that returns the first day of the quarter after the date's quarter.
+func FirstDayOfNextQuarter() Adjuster {
+	return func(d LocalDate) LocalDate {
+		return firstDayOfNextUnit(d, UnitQuarter)
+	}
+}
+
+// FirstDayOfYear returns an Adjuster that returns the first day of the date's year.
+func FirstDayOfYear() Adjuster {
+	return func(d LocalDate) LocalDate {
+		return firstDayOfUnit(d, UnitYear)
+	}
+}
+
+// LastDayOfYear returns an Adjuster that returns the last day of the date's year.
+func LastDayOfYear() Adjuster {
+	return func(d LocalDate) LocalDate {
+		return firstDayOfNextUnit(d, UnitYear) - 1
+	}
+}
+
+// FirstDayOfNextYear returns an Adjuster that returns the first day of the year after the date's year.
+func FirstDayOfNextYear() Adjuster {
+	return func(d LocalDate) LocalDate {
+		return firstDayOfNextUnit(d, UnitYear)
+	}
+}
+
+// Next returns an Adjuster that returns the first occurrence of weekday after the date.
+func Next(weekday Weekday) Adjuster {
+	return func(d LocalDate) LocalDate {
+		diff := (int(weekday) - int(d.Weekday()) + 7) % 7
+		if diff == 0 {
+			diff = 7
+		}
+		return addDaysToLocalDate(d, diff)
+	}
+}
+
+// NextOrSame returns an Adjuster that returns the first occurrence of weekday on or after the date.
+func NextOrSame(weekday Weekday) Adjuster {
+	return func(d LocalDate) LocalDate {
+		return addDaysToLocalDate(d, (int(weekday)-int(d.Weekday())+7)%7)
+	}
+}
+
+// Previous returns an Adjuster that returns the last occurrence of weekday before the date.
+func Previous(weekday Weekday) Adjuster {
+	return func(d LocalDate) LocalDate {
+		diff := (int(d.Weekday()) - int(weekday) + 7) % 7
+		if diff == 0 {
+			diff = 7
+		}
+		return addDaysToLocalDate(d, -diff)
+	}
+}
+
+// PreviousOrSame returns an Adjuster that returns the last occurrence of weekday on or before the date.
+func PreviousOrSame(weekday Weekday) Adjuster {
+	return func(d LocalDate) LocalDate {
+		return addDaysToLocalDate(d, -((int(d.Weekday()) - int(weekday) + 7) % 7))
+	}
+}
+
+// FirstInMonth returns an Adjuster that returns the first occurrence of weekday in the date's month.
+// It is equivalent to NthWeekdayOfMonth(1, weekday).
+func FirstInMonth(weekday Weekday) Adjuster {
+	return NthWeekdayOfMonth(1, weekday)
+}
+
+// LastInMonth returns an Adjuster that returns the last occurrence of weekday in the date's month.
+// It is equivalent to NthWeekdayOfMonth(-1, weekday).
+func LastInMonth(weekday Weekday) Adjuster {
+	return NthWeekdayOfMonth(-1, weekday)
+}
+
+// NthWeekdayOfMonth returns an Adjuster that returns the nth occurrence of weekday in the date's month.
+// If n is negative, occurrences are counted backwards from the end of the month, such that -1 is the last occurrence.
+// If the month does not contain n occurrences of weekday, the result falls in the following month
+// (or the preceding month, if n is negative). This function panics if n is 0.
+func NthWeekdayOfMonth(n int, weekday Weekday) Adjuster {
+	if n == 0 {
+		panic("n must not be 0")
+	}
+
+	return func(d LocalDate) LocalDate {
+		if n > 0 {
+			return addDaysToLocalDate(NextOrSame(weekday)(firstDayOfUnit(d, UnitMonth)), (n-1)*7)
+		}
+		return addDaysToLocalDate(PreviousOrSame(weekday)(firstDayOfNextUnit(d, UnitMonth)-1), (n+1)*7)
+	}
+}
+
+func firstDayOfUnit(d LocalDate, u Unit) LocalDate {
+	v, err := truncateDateToUnit(int64(d), u)
+	if err != nil {
+		panic(err.Error())
+	}
+	return LocalDate(v)
+}
+
+func firstDayOfNextUnit(d LocalDate, u Unit) LocalDate {
+	v, err := addUnitsToDate(int64(firstDayOfUnit(d, u)), u, 1)
+	if err != nil {
+		panic(err.Error())
+	}
+	return LocalDate(v)
+}
+
+func addDaysToLocalDate(d LocalDate, days int) LocalDate {
+	v, err := addUnitsToDate(int64(d), UnitDay, days)
+	if err != nil {
+		panic(err.Error())
+	}
+	return LocalDate(v)
+}
@@ -0,0 +1,83 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestAdjuster(t *testing.T) {
+	d := chrono.LocalDateOf(2021, chrono.May, 19) // Wednesday.
+
+	for _, tt := range []struct {
+		name     string
+		adj      chrono.Adjuster
+		expected chrono.LocalDate
+	}{
+		{"FirstDayOfMonth", chrono.FirstDayOfMonth(), chrono.LocalDateOf(2021, chrono.May, 1)},
+		{"LastDayOfMonth", chrono.LastDayOfMonth(), chrono.LocalDateOf(2021, chrono.May, 31)},
+		{"FirstDayOfNextMonth", chrono.FirstDayOfNextMonth(), chrono.LocalDateOf(2021, chrono.June, 1)},
+		{"FirstDayOfQuarter", chrono.FirstDayOfQuarter(), chrono.LocalDateOf(2021, chrono.April, 1)},
+		{"LastDayOfQuarter", chrono.LastDayOfQuarter(), chrono.LocalDateOf(2021, chrono.June, 30)},
+		{"FirstDayOfNextQuarter", chrono.FirstDayOfNextQuarter(), chrono.LocalDateOf(2021, chrono.July, 1)},
+		{"FirstDayOfYear", chrono.FirstDayOfYear(), chrono.LocalDateOf(2021, chrono.January, 1)},
+		{"LastDayOfYear", chrono.LastDayOfYear(), chrono.LocalDateOf(2021, chrono.December, 31)},
+		{"FirstDayOfNextYear", chrono.FirstDayOfNextYear(), chrono.LocalDateOf(2022, chrono.January, 1)},
+		{"Next same", chrono.Next(chrono.Wednesday), chrono.LocalDateOf(2021, chrono.May, 26)},
+		{"Next", chrono.Next(chrono.Friday), chrono.LocalDateOf(2021, chrono.May, 21)},
+		{"NextOrSame same", chrono.NextOrSame(chrono.Wednesday), chrono.LocalDateOf(2021, chrono.May, 19)},
+		{"NextOrSame", chrono.NextOrSame(chrono.Monday), chrono.LocalDateOf(2021, chrono.May, 24)},
+		{"Previous same", chrono.Previous(chrono.Wednesday), chrono.LocalDateOf(2021, chrono.May, 12)},
+		{"Previous", chrono.Previous(chrono.Monday), chrono.LocalDateOf(2021, chrono.May, 17)},
+		{"PreviousOrSame same", chrono.PreviousOrSame(chrono.Wednesday), chrono.LocalDateOf(2021, chrono.May, 19)},
+		{"PreviousOrSame", chrono.PreviousOrSame(chrono.Thursday), chrono.LocalDateOf(2021, chrono.May, 13)},
+		{"FirstInMonth", chrono.FirstInMonth(chrono.Monday), chrono.LocalDateOf(2021, chrono.May, 3)},
+		{"FirstInMonth first day", chrono.FirstInMonth(chrono.Saturday), chrono.LocalDateOf(2021, chrono.May, 1)},
+		{"LastInMonth", chrono.LastInMonth(chrono.Friday), chrono.LocalDateOf(2021, chrono.May, 28)},
+		{"LastInMonth last day", chrono.LastInMonth(chrono.Monday), chrono.LocalDateOf(2021, chrono.May, 31)},
+		{"NthWeekdayOfMonth", chrono.NthWeekdayOfMonth(2, chrono.Friday), chrono.LocalDateOf(2021, chrono.May, 14)},
+		{"NthWeekdayOfMonth overflow", chrono.NthWeekdayOfMonth(5, chrono.Friday), chrono.LocalDateOf(2021, chrono.June, 4)},
+		{"NthWeekdayOfMonth negative", chrono.NthWeekdayOfMonth(-2, chrono.Monday), chrono.LocalDateOf(2021, chrono.May, 24)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := d.With(tt.adj); out != tt.expected {
+				t.Errorf("d.With() = %s, want %s", out, tt.expected)
+			}
+		})
+	}
+
+	t.Run("leap year", func(t *testing.T) {
+		if out := chrono.LocalDateOf(2020, chrono.February, 3).With(chrono.LastDayOfMonth()); out != chrono.LocalDateOf(2020, chrono.February, 29) {
+			t.Errorf("d.With() = %s, want 2020-02-29", out)
+		}
+	})
+
+	t.Run("composed", func(t *testing.T) {
+		if out := d.With(chrono.FirstDayOfNextMonth()).With(chrono.FirstInMonth(chrono.Monday)); out != chrono.LocalDateOf(2021, chrono.June, 7) {
+			t.Errorf("d.With() = %s, want 2021-06-07", out)
+		}
+	})
+
+	t.Run("LocalDateTime", func(t *testing.T) {
+		dt := chrono.LocalDateTimeOf(2021, chrono.May, 19, 13, 30, 0, 0).With(chrono.LastDayOfQuarter())
+		if expected := chrono.LocalDateTimeOf(2021, chrono.June, 30, 13, 30, 0, 0); dt.Compare(expected) != 0 {
+			t.Errorf("dt.With() = %s, want %s", dt, expected)
+		}
+	})
+
+	t.Run("OffsetDateTime", func(t *testing.T) {
+		dt := chrono.OffsetDateTimeOf(2021, chrono.May, 19, 13, 30, 0, 0, -5, 0).With(chrono.Next(chrono.Friday))
+		if expected := "2021-05-21 13:30:00-05:00"; dt.String() != expected {
+			t.Errorf("dt.With() = %s, want %s", dt, expected)
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expecting panic")
+			}
+		}()
+		chrono.MaxLocalDate().With(chrono.FirstDayOfNextYear())
+	})
+}
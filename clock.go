@@ -0,0 +1,43 @@
+package chrono
+
+import "time"
+
+// Clock provides monotonic and wall clock readings, along with timers that are driven by its monotonic clock.
+// Code that accepts a Clock, rather than calling Now and NowUTC directly, can be tested deterministically
+// by substituting a fake clock, such as the one provided by the chronotest package.
+//
+// Implementations of Clock must be safe for concurrent use.
+type Clock interface {
+	// Now returns a monotonic reading of the current point in time, in the same manner as the package-level Now.
+	Now() Instant
+	// NowUTC returns a reading of the wall clock in UTC, in the same manner as the package-level NowUTC.
+	NowUTC() OffsetDateTime
+	// AfterFunc waits for the extent d to elapse according to the monotonic clock, and then calls f.
+	// If d is not positive, f is called as soon as possible. The returned function stops the timer,
+	// and reports false if the timer had already fired or been stopped.
+	//
+	// SystemClock calls f in its own goroutine, but fake clocks, such as the one provided by the chronotest package,
+	// may call f synchronously from the goroutine that moves the clock forward. Functions passed to AfterFunc
+	// should therefore return promptly, without waiting on other goroutines.
+	AfterFunc(d Extent, f func()) (stop func() bool)
+}
+
+// SystemClock returns a Clock that reads the system's clocks, as described for Now and NowUTC,
+// and whose timers are provided by the standard library's time package.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() Instant {
+	return Now()
+}
+
+func (systemClock) NowUTC() OffsetDateTime {
+	return NowUTC()
+}
+
+func (systemClock) AfterFunc(d Extent, f func()) func() bool {
+	return time.AfterFunc(time.Duration(d), f).Stop
+}
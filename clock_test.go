@@ -0,0 +1,24 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestSystemClock(t *testing.T) {
+	c := chrono.SystemClock()
+	start := c.Now()
+
+	fired := make(chan chrono.Instant, 1)
+	c.AfterFunc(10*chrono.Millisecond, func() { fired <- c.Now() })
+
+	if elapsed := start.Until(<-fired); elapsed.Compare(chrono.DurationOf(10*chrono.Millisecond)) < 0 {
+		t.Errorf("timer fired after %s, want at least 10ms", elapsed)
+	}
+
+	stop := c.AfterFunc(chrono.Hour, func() { t.Error("stopped timer fired") })
+	if !stop() {
+		t.Error("stop() = false, want true")
+	}
+}
@@ -0,0 +1,57 @@
+package chrono
+
+import (
+	"context"
+	"math/big"
+	"time"
+)
+
+// The functions in this file derive contexts whose deadlines are specified using chrono values.
+// Since contexts measure their deadlines using the standard library's time package, they always use the system's clocks.
+// Durations that exceed the range of time.Duration (approximately ±292 years) are clamped to its range.
+
+// WithDeadline returns a copy of parent whose deadline is no later than the wall clock time t,
+// in the same manner as context.WithDeadline.
+func WithDeadline(parent context.Context, t OffsetDateTime) (context.Context, context.CancelFunc) {
+	return context.WithDeadline(parent, toTime(t))
+}
+
+// WithInstantDeadline returns a copy of parent whose deadline is no later than the instant i,
+// as measured by the monotonic clock read by Now.
+func WithInstantDeadline(parent context.Context, i Instant) (context.Context, context.CancelFunc) {
+	return WithTimeout(parent, i.Sub(Now()))
+}
+
+// WithTimeout returns a copy of parent whose deadline is no later than the duration d from now,
+// in the same manner as context.WithTimeout.
+func WithTimeout(parent context.Context, d Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(parent, time.Duration(clampExtent(d)))
+}
+
+// Deadline returns the deadline of ctx as a wall clock time in UTC.
+// ok is false if ctx has no deadline.
+func Deadline(ctx context.Context) (deadline OffsetDateTime, ok bool) {
+	t, ok := ctx.Deadline()
+	if !ok {
+		return OffsetDateTime{}, false
+	}
+	return OffsetDateTime{v: unixToDateTime(t.Unix(), int64(t.Nanosecond()))}, true
+}
+
+// Remaining returns the duration until the deadline of ctx, which is negative if the deadline has passed.
+// ok is false if ctx has no deadline.
+func Remaining(ctx context.Context) (d Duration, ok bool) {
+	t, ok := ctx.Deadline()
+	if !ok {
+		return Duration{}, false
+	}
+	return DurationOf(Extent(time.Until(t))), true
+}
+
+// toTime converts d to a time.Time.
+func toTime(d OffsetDateTime) time.Time {
+	v := d.utc()
+	var nsec big.Int
+	secs, _ := new(big.Int).DivMod(&v, bigIntSecondExtent, &nsec)
+	return time.Unix(secs.Int64(), nsec.Int64())
+}
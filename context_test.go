@@ -0,0 +1,63 @@
+package chrono_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestWithDeadline(t *testing.T) {
+	deadline := chrono.NowOffsetDateTime(chrono.OffsetOf(2, 0)).Add(chrono.DurationOf(chrono.Hour))
+
+	ctx, cancel := chrono.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	if d, ok := chrono.Deadline(ctx); !ok {
+		t.Error("no deadline")
+	} else if d.String() != deadline.UTC().String() {
+		t.Errorf("deadline = %s, want %s", d, deadline.UTC())
+	}
+
+	if d, ok := chrono.Remaining(ctx); !ok {
+		t.Error("no deadline")
+	} else if d.Compare(chrono.DurationOf(chrono.Hour)) > 0 || d.Compare(chrono.DurationOf(59*chrono.Minute)) < 0 {
+		t.Errorf("remaining = %s, want approximately PT1H", d)
+	}
+}
+
+func TestWithInstantDeadline(t *testing.T) {
+	ctx, cancel := chrono.WithInstantDeadline(context.Background(), chrono.Now().Add(chrono.DurationOf(-chrono.Second)))
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+	default:
+		t.Error("context not done")
+	}
+
+	if d, ok := chrono.Remaining(ctx); !ok || d.Compare(chrono.Duration{}) >= 0 {
+		t.Errorf("remaining = %s, want negative", d)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	t.Run("clamped", func(t *testing.T) {
+		ctx, cancel := chrono.WithTimeout(context.Background(), chrono.MaxDuration())
+		defer cancel()
+
+		if d, ok := chrono.Remaining(ctx); !ok || d.Compare(chrono.DurationOf(100*365*24*chrono.Hour)) < 0 {
+			t.Errorf("remaining = %s", d)
+		}
+	})
+
+	t.Run("no deadline", func(t *testing.T) {
+		if _, ok := chrono.Remaining(context.Background()); ok {
+			t.Error("ok = true, want false")
+		}
+
+		if _, ok := chrono.Deadline(context.Background()); ok {
+			t.Error("ok = true, want false")
+		}
+	})
+}
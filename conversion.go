@@ -0,0 +1,156 @@
+package chrono
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// ConversionPolicy specifies how the components of a Period are converted to and from a Duration.
+// Weeks are always 7 days long, and days are always 24 hours long.
+// The length of years and months depends on the policy: either they are measured in the calendar,
+// starting from an anchor date, or they are assigned a conventional fixed length.
+//
+// The zero value converts only weeks and days, and returns an error for any period that contains years or months.
+type ConversionPolicy struct {
+	year, month Extent
+	anchored    bool
+	anchor      LocalDateTime
+}
+
+// AnchoredConversion returns a ConversionPolicy that measures periods in the calendar, starting from anchor.
+// A period is converted to the duration between anchor and anchor.AddDate(p), and a duration is converted to
+// the period between anchor and anchor.Add(d) as returned by BetweenDateTimes.
+func AnchoredConversion(anchor LocalDateTime) ConversionPolicy {
+	return ConversionPolicy{anchored: true, anchor: anchor}
+}
+
+// ThirtyDayConversion returns a ConversionPolicy in which every month is 30 days long, and every year is 365 days long.
+func ThirtyDayConversion() ConversionPolicy {
+	return ConversionPolicy{year: 365 * 24 * Hour, month: 30 * 24 * Hour}
+}
+
+// GregorianConversion returns a ConversionPolicy in which every year is the average length of a year in the Gregorian calendar,
+// 365.2425 days, and every month is a twelfth of that year, 30.436875 days.
+func GregorianConversion() ConversionPolicy {
+	return ConversionPolicy{year: 31556952 * Second, month: 2629746 * Second}
+}
+
+// ToDuration converts p to a Duration according to policy.
+// The conversion is exact if the result does not rely on a conventional length for years or months,
+// and no part of a fractional component had to be truncated (as described for Period when adding to a date).
+// An error is returned if policy cannot convert the components of p, or if the result is out of range.
+func (p Period) ToDuration(policy ConversionPolicy) (d Duration, exact bool, err error) {
+	if policy.anchored {
+		v, err := PeriodDuration{Period: p}.addToBigDate(policy.anchor.v, false)
+		if err != nil {
+			return Duration{}, false, err
+		}
+
+		v.Sub(&v, &policy.anchor.v)
+		return Duration{v: v}, p.isDateExact(), nil
+	}
+
+	f, u := p.fraction()
+	calendar := p.Years != 0 || p.Months != 0 || u == UnitYear || u == UnitMonth
+	if calendar && policy.year == 0 {
+		return Duration{}, false, fmt.Errorf("cannot convert years or months without an anchor or conventional lengths")
+	}
+
+	out := new(big.Int)
+	for _, c := range []struct {
+		value int
+		unit  Unit
+	}{
+		{p.Years, UnitYear},
+		{p.Months, UnitMonth},
+		{p.Weeks, UnitWeek},
+		{p.Days, UnitDay},
+	} {
+		v := big.NewInt(int64(c.value))
+		out.Add(out, v.Mul(v, big.NewInt(int64(policy.length(c.unit)))))
+	}
+
+	exact = !calendar
+	if f != 0 {
+		var rem big.Int
+		v := big.NewInt(int64(f))
+		v.QuoRem(v.Mul(v, big.NewInt(int64(policy.length(u)))), big.NewInt(fractionScale), &rem)
+		out.Add(out, v)
+		exact = exact && rem.Sign() == 0
+	}
+
+	if d, err = checkDuration(out); err != nil {
+		return Duration{}, false, err
+	}
+	return d, exact, nil
+}
+
+// length returns the fixed length of the unit u according to policy.
+func (policy ConversionPolicy) length(u Unit) Extent {
+	switch u {
+	case UnitYear:
+		return policy.year
+	case UnitMonth:
+		return policy.month
+	case UnitWeek:
+		return 7 * 24 * Hour
+	}
+	return 24 * Hour
+}
+
+// isDateExact reports whether the fraction of p can be applied to a datetime without truncation.
+func (p Period) isDateExact() bool {
+	switch f, u := p.fraction(); u {
+	case UnitYear:
+		return f*12%fractionScale == 0
+	case UnitMonth:
+		return false
+	}
+	return true
+}
+
+// ToPeriod converts d to a Period according to policy, along with the remaining part of d that is shorter than a day.
+// The period never contains weeks or a fraction. With a conventional policy, d is divided into as many whole years as possible,
+// followed by months and then days. With the zero value policy, d is converted only to days.
+// The period and remainder have the same sign as d, except with an anchored policy, where they are as returned by BetweenDateTimes.
+//
+// The conversion is exact unless the result relies on a conventional length for years or months,
+// which is the case only if the period contains years or months.
+// An error is returned if the result is out of range.
+func (d Duration) ToPeriod(policy ConversionPolicy) (p Period, rem Duration, exact bool, err error) {
+	if policy.anchored {
+		end, err := addDurationToBigDate(policy.anchor.v, d)
+		if err != nil {
+			return Period{}, Duration{}, false, err
+		}
+
+		p, rem = betweenDateTimes(policy.anchor.v, end)
+		return p, rem, true, nil
+	}
+
+	var v big.Int
+	v.Set(&d.v)
+
+	var years, months, days big.Int
+	if policy.year != 0 {
+		years.QuoRem(&v, big.NewInt(int64(policy.year)), &v)
+		months.QuoRem(&v, big.NewInt(int64(policy.month)), &v)
+	}
+	days.QuoRem(&v, bigIntDayExtent, &v)
+
+	for _, c := range []struct {
+		out *int
+		v   *big.Int
+	}{
+		{&p.Years, &years},
+		{&p.Months, &months},
+		{&p.Days, &days},
+	} {
+		if !c.v.IsInt64() || c.v.Int64() > math.MaxInt || c.v.Int64() < math.MinInt {
+			return Period{}, Duration{}, false, fmt.Errorf("period out of range")
+		}
+		*c.out = int(c.v.Int64())
+	}
+	return p, Duration{v: v}, p.Years == 0 && p.Months == 0, nil
+}
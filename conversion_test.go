@@ -0,0 +1,92 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestPeriod_ToDuration(t *testing.T) {
+	anchor := chrono.LocalDateTimeOf(2020, chrono.January, 31, 12, 0, 0, 0)
+
+	for _, tt := range []struct {
+		name     string
+		period   chrono.Period
+		policy   chrono.ConversionPolicy
+		expected chrono.Duration
+		exact    bool
+	}{
+		{"days", chrono.Period{Weeks: 1, Days: 2}, chrono.ConversionPolicy{}, chrono.DurationOf(9 * 24 * chrono.Hour), true},
+		{"fractional day", chrono.Period{Days: 1, Fraction: 500000000, FractionUnit: chrono.UnitDay}, chrono.ConversionPolicy{}, chrono.DurationOf(36 * chrono.Hour), true},
+		{"anchored", chrono.Period{Months: 1, Days: 1}, chrono.AnchoredConversion(anchor), chrono.DurationOf(32 * 24 * chrono.Hour), true},
+		{"anchored leap year", chrono.Period{Years: 1}, chrono.AnchoredConversion(anchor), chrono.DurationOf(366 * 24 * chrono.Hour), true},
+		{"anchored fractional month", chrono.Period{Fraction: 500000000, FractionUnit: chrono.UnitMonth}, chrono.AnchoredConversion(anchor), chrono.Duration{}, false},
+		{"thirty day", chrono.Period{Years: 1, Months: 2, Days: 3}, chrono.ThirtyDayConversion(), chrono.DurationOf(428 * 24 * chrono.Hour), false},
+		{"gregorian", chrono.Period{Years: 4}, chrono.GregorianConversion(), chrono.DurationOf(1460*24*chrono.Hour + 23*chrono.Hour + 16*chrono.Minute + 48*chrono.Second), false},
+		{"gregorian month", chrono.Period{Fraction: 500000000, FractionUnit: chrono.UnitYear}, chrono.GregorianConversion(), chrono.DurationOf(6 * 2629746 * chrono.Second), false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d, exact, err := tt.period.ToDuration(tt.policy)
+			if err != nil {
+				t.Fatalf("p.ToDuration() error = %v", err)
+			}
+
+			if d.Compare(tt.expected) != 0 {
+				t.Errorf("p.ToDuration() = %s, want %s", d, tt.expected)
+			}
+
+			if exact != tt.exact {
+				t.Errorf("exact = %t, want %t", exact, tt.exact)
+			}
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		if _, _, err := (chrono.Period{Months: 1}).ToDuration(chrono.ConversionPolicy{}); err == nil {
+			t.Error("expecting error but got nil")
+		}
+	})
+}
+
+func TestDuration_ToPeriod(t *testing.T) {
+	anchor := chrono.LocalDateTimeOf(2020, chrono.January, 31, 12, 0, 0, 0)
+
+	for _, tt := range []struct {
+		name     string
+		duration chrono.Duration
+		policy   chrono.ConversionPolicy
+		expected chrono.Period
+		rem      chrono.Duration
+		exact    bool
+	}{
+		{"days", chrono.DurationOf(54 * chrono.Hour), chrono.ConversionPolicy{}, chrono.Period{Days: 2}, chrono.DurationOf(6 * chrono.Hour), true},
+		{"negative days", chrono.DurationOf(-54 * chrono.Hour), chrono.ConversionPolicy{}, chrono.Period{Days: -2}, chrono.DurationOf(-6 * chrono.Hour), true},
+		{"hour", chrono.DurationOf(chrono.Hour), chrono.ConversionPolicy{}, chrono.Period{}, chrono.DurationOf(chrono.Hour), true},
+		{"nanosecond", chrono.DurationOf(chrono.Nanosecond), chrono.ConversionPolicy{}, chrono.Period{}, chrono.DurationOf(chrono.Nanosecond), true},
+		{"anchored", chrono.DurationOf(32 * 24 * chrono.Hour), chrono.AnchoredConversion(anchor), chrono.Period{Months: 1, Days: 1}, chrono.Duration{}, true},
+		{"anchored months", chrono.DurationOf(62 * 24 * chrono.Hour), chrono.AnchoredConversion(anchor), chrono.Period{Months: 2, Days: 2}, chrono.Duration{}, true},
+		{"anchored hour", chrono.DurationOf(chrono.Hour), chrono.AnchoredConversion(anchor), chrono.Period{}, chrono.DurationOf(chrono.Hour), true},
+		{"thirty day", chrono.DurationOf(428 * 24 * chrono.Hour), chrono.ThirtyDayConversion(), chrono.Period{Years: 1, Months: 2, Days: 3}, chrono.Duration{}, false},
+		{"thirty day days only", chrono.DurationOf(29*24*chrono.Hour + chrono.Hour), chrono.ThirtyDayConversion(), chrono.Period{Days: 29}, chrono.DurationOf(chrono.Hour), true},
+		{"gregorian hour", chrono.DurationOf(chrono.Hour), chrono.GregorianConversion(), chrono.Period{}, chrono.DurationOf(chrono.Hour), true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			p, rem, exact, err := tt.duration.ToPeriod(tt.policy)
+			if err != nil {
+				t.Fatalf("d.ToPeriod() error = %v", err)
+			}
+
+			if !p.Equal(tt.expected) {
+				t.Errorf("d.ToPeriod() = %s, want %s", p, tt.expected)
+			}
+
+			if rem.Compare(tt.rem) != 0 {
+				t.Errorf("d.ToPeriod() remainder = %s, want %s", rem, tt.rem)
+			}
+
+			if exact != tt.exact {
+				t.Errorf("exact = %t, want %t", exact, tt.exact)
+			}
+		})
+	}
+}
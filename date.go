@@ -121,7 +121,7 @@ func isDateValid(year, month, date int) bool {
 
 func fromDate(v int64) (year, month, day int, err error) {
 	if v < minJDN || v > maxJDN {
-		return 0, 0, 0, fmt.Errorf("invalid date")
+		return 0, 0, 0, errOutOfRange("invalid date")
 	}
 
 	dd := int64(v + unixEpochJDN)
@@ -152,21 +152,83 @@ func getDaysInYear(year int) int {
 	return 365
 }
 
+func getDaysInMonth(year, month int) int {
+	if isLeapYear(year) && month == int(February) {
+		return 29
+	}
+	return daysInMonths[month-1]
+}
+
+// withYearMonth returns the date v with its year and month replaced. If the day does not exist in the resulting month,
+// it is clamped to the last day of the month.
+func withYearMonth(v int64, year, month int) (int64, error) {
+	if month < int(January) || month > int(December) {
+		return 0, errInvalidDate("invalid month")
+	}
+
+	_, _, day, err := fromDate(v)
+	if err != nil {
+		return 0, err
+	}
+
+	if n := getDaysInMonth(year, month); day > n {
+		day = n
+	}
+	return makeDate(year, month, day)
+}
+
+func mustDate(v int64, err error) int64 {
+	if err != nil {
+		panic(err.Error())
+	}
+	return v
+}
+
+// withDay returns the date v with its day of the month replaced.
+func withDay(v int64, day int) (int64, error) {
+	year, month, _, err := fromDate(v)
+	if err != nil {
+		return 0, err
+	}
+	return makeValidDate(year, month, day)
+}
+
+// makeValidDate returns the JDN of the specified date, as by makeDate, but it also requires the date to exist in the calendar.
+func makeValidDate(year, month, day int) (int64, error) {
+	if !isDateValid(year, month, day) {
+		return 0, errInvalidDate("invalid date")
+	}
+	return makeDate(year, month, day)
+}
+
 func makeDate(year, month, day int) (int64, error) {
-	if !isDateInBounds(year, month, day) {
-		return 0, fmt.Errorf("date out of bounds")
+	y, m := normalizeMonth(int64(year), int64(month))
+	if !isDateInBounds(int(y), int(m), day) {
+		return 0, errOutOfRange("date out of bounds")
 	}
 	return makeJDN(int64(year), int64(month), int64(day)), nil
 }
 
 func makeJDN(y, m, d int64) int64 {
+	y, m = normalizeMonth(y, m)
 	return (1461*(y+4800+(m-14)/12))/4 + (367*(m-2-12*((m-14)/12)))/12 - (3*((y+4900+(m-14)/12)/100))/4 + d - 32075 - unixEpochJDN
 }
 
+// normalizeMonth carries any number of months outside of the range 1-12 into the year.
+func normalizeMonth(year, month int64) (int64, int64) {
+	month--
+	year += month / 12
+	if month %= 12; month < 0 {
+		year--
+		month += 12
+	}
+	return year, month + 1
+}
+
 func ofDayOfYear(year, day int) (int64, error) {
 	isLeap := isLeapYear(year)
-	if (!isLeap && day > 365) || day > 366 {
-		return 0, fmt.Errorf("invalid date")
+	if day < 1 || (!isLeap && day > 365) || day > 366 {
+		return 0, errInvalidDate("invalid date")
 	}
 
 	var month Month
@@ -221,6 +283,34 @@ func addDateToDate(d int64, years, months, days int) (int64, error) {
 	return out, err
 }
 
+// betweenDates returns the years, months and days that must be passed to addDateToDate in order to reach b from a.
+// The number of months is maximized, and each component has the same sign as b-a.
+func betweenDates(a, b int64) (years, months, days int, err error) {
+	ya, ma, da, err := fromDate(a)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	yb, mb, _, err := fromDate(b)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	// Adding months to a can overflow into the following month, so step back until the date is not past b.
+	total := int64((yb*12 + mb) - (ya*12 + ma))
+	date := func() int64 { return makeJDN(int64(ya), int64(ma)+total, int64(da)) }
+	if b >= a {
+		for total > 0 && date() > b {
+			total--
+		}
+	} else {
+		for total < 0 && date() < b {
+			total++
+		}
+	}
+	return int(total / 12), int(total % 12), int(b - date()), nil
+}
+
 func simpleDateStr(year, month, day int) string {
 	return fmt.Sprintf("%04d-%02d-%02d", year, month, day)
 }
@@ -282,7 +372,7 @@ func addDurationToBigDate(d big.Int, v Duration) (big.Int, error) {
 	out.Add(out, &v.v)
 
 	if out.Cmp(&minLocalDateTime.v) == -1 || out.Cmp(&maxLocalDateTime.v) == 1 {
-		return big.Int{}, fmt.Errorf("datetime out of range")
+		return big.Int{}, errOutOfRange("datetime out of range")
 	}
 	return *out, nil
 }
@@ -303,7 +393,7 @@ func addDateToBigDate(d big.Int, years, months, days int) (big.Int, error) {
 	}
 
 	if added < minJDN || added > maxJDN {
-		return big.Int{}, fmt.Errorf("date out of bounds")
+		return big.Int{}, errOutOfRange("date out of bounds")
 	}
 
 	diff := big.NewInt(int64(added - date))
@@ -315,6 +405,26 @@ func addDateToBigDate(d big.Int, years, months, days int) (big.Int, error) {
 	return *out, nil
 }
 
+// withDateOfBigDate returns the datetime v with its date replaced by the result of f, and the same time of day.
+func withDateOfBigDate(v big.Int, f func(date int64) (int64, error)) big.Int {
+	date, time := splitDateAndTime(v)
+	date, err := f(date)
+	if err != nil {
+		panic(err.Error())
+	}
+	return makeDateTime(date, time)
+}
+
+// withTimeOfBigDate returns the datetime v with its time of day replaced by the time whose fields are returned by f.
+func withTimeOfBigDate(v big.Int, f func(hour, min, sec, nsec int) (int, int, int, int)) big.Int {
+	date, time := splitDateAndTime(v)
+	time, err := makeTimeOfDay(f(timeFields(time)))
+	if err != nil {
+		panic(err.Error())
+	}
+	return makeDateTime(date, time)
+}
+
 func splitDateAndTime(v big.Int) (date, time int64) {
 	vv := new(big.Int).Set(&v)
 
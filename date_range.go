@@ -0,0 +1,261 @@
+package chrono
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// LocalDateRange is a sequence of dates, starting from a start date and separated by a fixed Period,
+// which ends before (or optionally at) an end date.
+//
+// Each date is calculated by adding a multiple of the step to the start date, rather than by repeatedly adding the step
+// to the previous date. If a date falls beyond the end of its month, it is clamped to the last day of the month,
+// such that a monthly range starting on 31st January continues with 28th February, 31st March and 30th April.
+// The multiple of the step's years and months is applied first, then the multiple of its weeks and days.
+//
+// The sequence stops early if the next date cannot be represented.
+// A LocalDateRange must be created using NewLocalDateRange or NewLocalDateRangeInclusive.
+type LocalDateRange struct {
+	r dateRange
+}
+
+// NewLocalDateRange returns a LocalDateRange of the dates from start, separated by step, that are before end.
+// An error is returned if step is not positive, meaning that none of its components may be negative and at least one must be positive,
+// or if step contains a fraction.
+func NewLocalDateRange(start, end LocalDate, step Period) (LocalDateRange, error) {
+	return newLocalDateRange(start, end, step, false)
+}
+
+// NewLocalDateRangeInclusive returns a LocalDateRange in the same manner as NewLocalDateRange,
+// except that end is also included if it falls on a step.
+func NewLocalDateRangeInclusive(start, end LocalDate, step Period) (LocalDateRange, error) {
+	return newLocalDateRange(start, end, step, true)
+}
+
+func newLocalDateRange(start, end LocalDate, step Period, inclusive bool) (LocalDateRange, error) {
+	r, err := newDateRange(makeDateTime(int64(start), 0), makeDateTime(int64(end), 0), PeriodDuration{Period: step}, inclusive)
+	if err != nil {
+		return LocalDateRange{}, err
+	}
+	return LocalDateRange{r: r}, nil
+}
+
+// Len returns the number of dates in r.
+func (r LocalDateRange) Len() int {
+	return int(r.r.len())
+}
+
+// Iterator returns a LocalDateIterator that steps through the dates of r in chronological order.
+func (r LocalDateRange) Iterator() *LocalDateIterator {
+	return &LocalDateIterator{it: r.r.iterator(false)}
+}
+
+// ReverseIterator returns a LocalDateIterator that steps through the dates of r in reverse chronological order.
+func (r LocalDateRange) ReverseIterator() *LocalDateIterator {
+	return &LocalDateIterator{it: r.r.iterator(true)}
+}
+
+// LocalDateIterator steps through the dates of a LocalDateRange.
+type LocalDateIterator struct {
+	it rangeIterator
+}
+
+// Next returns the next date. If there are no more dates, ok is false.
+func (it *LocalDateIterator) Next() (d LocalDate, ok bool) {
+	v, ok := it.it.next()
+	if !ok {
+		return 0, false
+	}
+	date, _ := splitDateAndTime(v)
+	return LocalDate(date), true
+}
+
+// LocalDateTimeRange is a sequence of datetimes, starting from a start datetime and separated by a fixed PeriodDuration,
+// which ends before (or optionally at) an end datetime. Each datetime is calculated in the same manner as for LocalDateRange,
+// with the multiple of the step's duration applied last.
+//
+// The sequence stops early if the next datetime cannot be represented.
+// A LocalDateTimeRange must be created using NewLocalDateTimeRange or NewLocalDateTimeRangeInclusive.
+type LocalDateTimeRange struct {
+	r dateRange
+}
+
+// NewLocalDateTimeRange returns a LocalDateTimeRange of the datetimes from start, separated by step, that are before end.
+// An error is returned if step is not positive, meaning that none of its components may be negative and at least one must be positive,
+// or if the period of step contains a fraction.
+func NewLocalDateTimeRange(start, end LocalDateTime, step PeriodDuration) (LocalDateTimeRange, error) {
+	return newLocalDateTimeRange(start, end, step, false)
+}
+
+// NewLocalDateTimeRangeInclusive returns a LocalDateTimeRange in the same manner as NewLocalDateTimeRange,
+// except that end is also included if it falls on a step.
+func NewLocalDateTimeRangeInclusive(start, end LocalDateTime, step PeriodDuration) (LocalDateTimeRange, error) {
+	return newLocalDateTimeRange(start, end, step, true)
+}
+
+func newLocalDateTimeRange(start, end LocalDateTime, step PeriodDuration, inclusive bool) (LocalDateTimeRange, error) {
+	r, err := newDateRange(start.v, end.v, step, inclusive)
+	if err != nil {
+		return LocalDateTimeRange{}, err
+	}
+	return LocalDateTimeRange{r: r}, nil
+}
+
+// Len returns the number of datetimes in r.
+func (r LocalDateTimeRange) Len() int {
+	return int(r.r.len())
+}
+
+// Iterator returns a LocalDateTimeIterator that steps through the datetimes of r in chronological order.
+func (r LocalDateTimeRange) Iterator() *LocalDateTimeIterator {
+	return &LocalDateTimeIterator{it: r.r.iterator(false)}
+}
+
+// ReverseIterator returns a LocalDateTimeIterator that steps through the datetimes of r in reverse chronological order.
+func (r LocalDateTimeRange) ReverseIterator() *LocalDateTimeIterator {
+	return &LocalDateTimeIterator{it: r.r.iterator(true)}
+}
+
+// LocalDateTimeIterator steps through the datetimes of a LocalDateTimeRange.
+type LocalDateTimeIterator struct {
+	it rangeIterator
+}
+
+// Next returns the next datetime. If there are no more datetimes, ok is false.
+func (it *LocalDateTimeIterator) Next() (d LocalDateTime, ok bool) {
+	v, ok := it.it.next()
+	if !ok {
+		return LocalDateTime{}, false
+	}
+	return LocalDateTime{v: v}, true
+}
+
+// dateRange is the sequence of datetimes start + k*step for k = 0, 1, ..., that are before (or at) end.
+type dateRange struct {
+	start, end   big.Int
+	months, days int64
+	dur          big.Int
+	inclusive    bool
+}
+
+// maxRangeStep is larger than the number of months or days in any step that can produce a valid datetime
+// when multiplied by a positive integer.
+const maxRangeStep = 1 << 62
+
+func newDateRange(start, end big.Int, step PeriodDuration, inclusive bool) (dateRange, error) {
+	p := step.Period
+	if p.Fraction != 0 {
+		return dateRange{}, fmt.Errorf("step must not contain a fraction")
+	} else if p.Years < 0 || p.Months < 0 || p.Weeks < 0 || p.Days < 0 || step.Duration.v.Sign() < 0 ||
+		(p.Years == 0 && p.Months == 0 && p.Weeks == 0 && p.Days == 0 && step.Duration.v.Sign() == 0) {
+		return dateRange{}, fmt.Errorf("step must be positive")
+	}
+
+	months := new(big.Int).Mul(big.NewInt(int64(p.Years)), big.NewInt(12))
+	months.Add(months, big.NewInt(int64(p.Months)))
+	days := new(big.Int).Mul(big.NewInt(int64(p.Weeks)), big.NewInt(7))
+	days.Add(days, big.NewInt(int64(p.Days)))
+	if months.Cmp(big.NewInt(maxRangeStep)) > 0 || days.Cmp(big.NewInt(maxRangeStep)) > 0 {
+		return dateRange{}, errOutOfRange("step out of range")
+	}
+
+	return dateRange{
+		start:     start,
+		end:       end,
+		months:    months.Int64(),
+		days:      days.Int64(),
+		dur:       step.Duration.v,
+		inclusive: inclusive,
+	}, nil
+}
+
+// at returns the kth datetime of r, or ok = false if it is after the end of r or cannot be represented.
+func (r *dateRange) at(k int64) (_ big.Int, ok bool) {
+	if k != 0 && (r.months > maxRangeStep/k || r.days > maxRangeStep/k) {
+		return big.Int{}, false
+	}
+
+	date, time := splitDateAndTime(r.start)
+	year, month, day, err := fromDate(date)
+	if err != nil {
+		return big.Int{}, false
+	}
+
+	months := int64(month-1) + k*r.months
+	if months/12 > maxYear {
+		return big.Int{}, false
+	}
+	year, month = year+int(months/12), int(months%12)+1
+	if n := getDaysInMonth(year, month); day > n {
+		day = n
+	}
+
+	date, err = makeDate(year, month, day)
+	if err != nil || k*r.days > maxJDN-date {
+		return big.Int{}, false
+	}
+
+	v := makeDateTime(date+k*r.days, time)
+	v.Add(&v, new(big.Int).Mul(&r.dur, big.NewInt(k)))
+	if _, err := checkBigDate(v); err != nil {
+		return big.Int{}, false
+	}
+
+	if cmp := v.Cmp(&r.end); cmp > 0 || (cmp == 0 && !r.inclusive) {
+		return big.Int{}, false
+	}
+	return v, true
+}
+
+// len returns the number of datetimes in r. Since the datetimes are strictly increasing,
+// it finds the first k for which at(k) is not ok using an exponential search followed by a binary search.
+func (r *dateRange) len() int64 {
+	if _, ok := r.at(0); !ok {
+		return 0
+	}
+
+	lo, hi := int64(0), int64(1)
+	for hi < maxRangeStep {
+		if _, ok := r.at(hi); !ok {
+			break
+		}
+		lo, hi = hi, hi*2
+	}
+
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if _, ok := r.at(mid); ok {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
+
+func (r *dateRange) iterator(reverse bool) rangeIterator {
+	if reverse {
+		return rangeIterator{r: r, k: r.len() - 1, step: -1}
+	}
+	return rangeIterator{r: r, step: 1}
+}
+
+type rangeIterator struct {
+	r       *dateRange
+	k, step int64
+	done    bool
+}
+
+func (it *rangeIterator) next() (big.Int, bool) {
+	if it.done || it.k < 0 {
+		return big.Int{}, false
+	}
+
+	v, ok := it.r.at(it.k)
+	if !ok {
+		it.done = true
+		return big.Int{}, false
+	}
+	it.k += it.step
+	return v, true
+}
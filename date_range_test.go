@@ -0,0 +1,158 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func collectDates(it *chrono.LocalDateIterator) []string {
+	var out []string
+	for d, ok := it.Next(); ok; d, ok = it.Next() {
+		out = append(out, d.String())
+	}
+	return out
+}
+
+func checkStrings(t *testing.T, out, expected []string) {
+	t.Helper()
+	if len(out) != len(expected) {
+		t.Fatalf("got %v, want %v", out, expected)
+	}
+
+	for i := range out {
+		if out[i] != expected[i] {
+			t.Errorf("item %d = %s, want %s", i, out[i], expected[i])
+		}
+	}
+}
+
+func TestLocalDateRange(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		start     chrono.LocalDate
+		end       chrono.LocalDate
+		step      chrono.Period
+		inclusive bool
+		expected  []string
+	}{
+		{"month end", chrono.LocalDateOf(2021, chrono.January, 31), chrono.LocalDateOf(2021, chrono.June, 1), chrono.Period{Months: 1}, false,
+			[]string{"2021-01-31", "2021-02-28", "2021-03-31", "2021-04-30", "2021-05-31"}},
+		{"every second Tuesday", chrono.LocalDateOf(2021, chrono.May, 4), chrono.LocalDateOf(2021, chrono.June, 1), chrono.Period{Weeks: 2}, false,
+			[]string{"2021-05-04", "2021-05-18"}},
+		{"inclusive", chrono.LocalDateOf(2021, chrono.May, 4), chrono.LocalDateOf(2021, chrono.June, 1), chrono.Period{Weeks: 2}, true,
+			[]string{"2021-05-04", "2021-05-18", "2021-06-01"}},
+		{"leap day", chrono.LocalDateOf(2020, chrono.February, 29), chrono.LocalDateOf(2024, chrono.February, 29), chrono.Period{Years: 1}, true,
+			[]string{"2020-02-29", "2021-02-28", "2022-02-28", "2023-02-28", "2024-02-29"}},
+		{"months and days", chrono.LocalDateOf(2021, chrono.January, 31), chrono.LocalDateOf(2021, chrono.May, 1), chrono.Period{Months: 1, Days: 1}, false,
+			[]string{"2021-01-31", "2021-03-01", "2021-04-02"}},
+		{"empty", chrono.LocalDateOf(2021, chrono.May, 4), chrono.LocalDateOf(2021, chrono.May, 4), chrono.Period{Days: 1}, false, nil},
+		{"end before start", chrono.LocalDateOf(2021, chrono.May, 4), chrono.LocalDateOf(2021, chrono.May, 1), chrono.Period{Days: 1}, true, nil},
+		{"max date", chrono.MaxLocalDate() - 2, chrono.MaxLocalDate(), chrono.Period{Days: 1}, true,
+			[]string{"5874898-06-01", "5874898-06-02", "5874898-06-03"}},
+		{"max date overflow", chrono.MaxLocalDate() - 2, chrono.MaxLocalDate(), chrono.Period{Months: 1}, true,
+			[]string{"5874898-06-01"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			newRange := chrono.NewLocalDateRange
+			if tt.inclusive {
+				newRange = chrono.NewLocalDateRangeInclusive
+			}
+
+			r, err := newRange(tt.start, tt.end, tt.step)
+			if err != nil {
+				t.Fatalf("failed to create range: %v", err)
+			}
+
+			if n := r.Len(); n != len(tt.expected) {
+				t.Errorf("r.Len() = %d, want %d", n, len(tt.expected))
+			}
+
+			checkStrings(t, collectDates(r.Iterator()), tt.expected)
+
+			reversed := make([]string, len(tt.expected))
+			for i, d := range tt.expected {
+				reversed[len(reversed)-1-i] = d
+			}
+			checkStrings(t, collectDates(r.ReverseIterator()), reversed)
+		})
+	}
+
+	t.Run("full range", func(t *testing.T) {
+		r, err := chrono.NewLocalDateRangeInclusive(chrono.MinLocalDate(), chrono.MaxLocalDate(), chrono.Period{Days: 1})
+		if err != nil {
+			t.Fatalf("failed to create range: %v", err)
+		}
+
+		if n, expected := r.Len(), int(chrono.MaxLocalDate()-chrono.MinLocalDate())+1; n != expected {
+			t.Errorf("r.Len() = %d, want %d", n, expected)
+		}
+	})
+
+	for _, tt := range []struct {
+		name string
+		step chrono.Period
+	}{
+		{"zero", chrono.Period{}},
+		{"negative", chrono.Period{Days: -1}},
+		{"mixed", chrono.Period{Months: 1, Days: -1}},
+		{"fraction", chrono.Period{Fraction: 500000000, FractionUnit: chrono.UnitDay}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := chrono.NewLocalDateRange(chrono.LocalDateOf(2021, chrono.May, 4), chrono.LocalDateOf(2021, chrono.June, 4), tt.step); err == nil {
+				t.Error("expecting error")
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeRange(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		start     chrono.LocalDateTime
+		end       chrono.LocalDateTime
+		step      chrono.PeriodDuration
+		inclusive bool
+		expected  []string
+	}{
+		{"duration", chrono.LocalDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0), chrono.LocalDateTimeOf(2021, chrono.January, 2, 0, 0, 0, 0),
+			chrono.PeriodDuration{Duration: chrono.DurationOf(6 * chrono.Hour)}, false,
+			[]string{"2021-01-01 00:00:00", "2021-01-01 06:00:00", "2021-01-01 12:00:00", "2021-01-01 18:00:00"}},
+		{"period and duration", chrono.LocalDateTimeOf(2021, chrono.January, 31, 10, 0, 0, 0), chrono.LocalDateTimeOf(2021, chrono.March, 31, 12, 0, 0, 0),
+			chrono.PeriodDuration{Period: chrono.Period{Months: 1}, Duration: chrono.DurationOf(chrono.Hour)}, false,
+			[]string{"2021-01-31 10:00:00", "2021-02-28 11:00:00"}},
+		{"inclusive", chrono.LocalDateTimeOf(2021, chrono.January, 31, 10, 0, 0, 0), chrono.LocalDateTimeOf(2021, chrono.March, 31, 12, 0, 0, 0),
+			chrono.PeriodDuration{Period: chrono.Period{Months: 1}, Duration: chrono.DurationOf(chrono.Hour)}, true,
+			[]string{"2021-01-31 10:00:00", "2021-02-28 11:00:00", "2021-03-31 12:00:00"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			newRange := chrono.NewLocalDateTimeRange
+			if tt.inclusive {
+				newRange = chrono.NewLocalDateTimeRangeInclusive
+			}
+
+			r, err := newRange(tt.start, tt.end, tt.step)
+			if err != nil {
+				t.Fatalf("failed to create range: %v", err)
+			}
+
+			if n := r.Len(); n != len(tt.expected) {
+				t.Errorf("r.Len() = %d, want %d", n, len(tt.expected))
+			}
+
+			var out []string
+			it := r.ReverseIterator()
+			for d, ok := it.Next(); ok; d, ok = it.Next() {
+				out = append([]string{d.String()}, out...)
+			}
+			checkStrings(t, out, tt.expected)
+		})
+	}
+
+	t.Run("negative duration", func(t *testing.T) {
+		step := chrono.PeriodDuration{Period: chrono.Period{Days: 1}, Duration: chrono.DurationOf(-chrono.Hour)}
+		if _, err := chrono.NewLocalDateTimeRange(chrono.LocalDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0), chrono.LocalDateTimeOf(2021, chrono.February, 1, 0, 0, 0, 0), step); err == nil {
+			t.Error("expecting error")
+		}
+	})
+}
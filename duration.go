@@ -2,6 +2,7 @@ package chrono
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"strconv"
 )
@@ -46,24 +47,260 @@ func (d Duration) CanAdd(d2 Duration) bool {
 	return err == nil
 }
 
+// AddChecked returns the duration d+d2, in the same manner as Add, but returns an error instead of panicking.
+func (d Duration) AddChecked(d2 Duration) (Duration, error) {
+	return d.add(d2)
+}
+
 func (d Duration) add(d2 Duration) (Duration, error) {
 	out := new(big.Int).Set(&d.v)
-	out.Add(out, &d2.v)
+	return checkDuration(out.Add(out, &d2.v))
+}
+
+// Sub returns the duration d-d2.
+// If the operation would overflow the maximum duration, or underflow the minimum duration, it panics.
+// Use CanSub to test whether a panic would occur.
+func (d Duration) Sub(d2 Duration) Duration {
+	out, err := d.sub(d2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanSub returns false if Sub would panic if passed the same argument.
+func (d Duration) CanSub(d2 Duration) bool {
+	_, err := d.sub(d2)
+	return err == nil
+}
+
+// SubChecked returns the duration d-d2, in the same manner as Sub, but returns an error instead of panicking.
+func (d Duration) SubChecked(d2 Duration) (Duration, error) {
+	return d.sub(d2)
+}
+
+func (d Duration) sub(d2 Duration) (Duration, error) {
+	out := new(big.Int).Set(&d.v)
+	return checkDuration(out.Sub(out, &d2.v))
+}
+
+// Neg returns the duration -d.
+// Since the range of durations is not symmetrical, this function panics if d is MinDuration.
+// Use CanNeg to test whether a panic would occur.
+func (d Duration) Neg() Duration {
+	out, err := d.mul(-1)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanNeg returns false if Neg would panic.
+func (d Duration) CanNeg() bool {
+	_, err := d.mul(-1)
+	return err == nil
+}
+
+// NegChecked returns the duration -d, in the same manner as Neg, but returns an error instead of panicking.
+func (d Duration) NegChecked() (Duration, error) {
+	return d.mul(-1)
+}
+
+// Abs returns the absolute value of d.
+// Since the range of durations is not symmetrical, this function panics if d is MinDuration.
+// Use CanAbs to test whether a panic would occur.
+func (d Duration) Abs() Duration {
+	out, err := d.abs()
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanAbs returns false if Abs would panic.
+func (d Duration) CanAbs() bool {
+	_, err := d.abs()
+	return err == nil
+}
+
+// AbsChecked returns the absolute value of d, in the same manner as Abs, but returns an error instead of panicking.
+func (d Duration) AbsChecked() (Duration, error) {
+	return d.abs()
+}
+
+func (d Duration) abs() (Duration, error) {
+	return checkDuration(new(big.Int).Abs(&d.v))
+}
 
-	if out.Cmp(bigIntMinInt64) == -1 || out.Cmp(bigIntMaxInt64) == 1 {
-		return Duration{}, fmt.Errorf("duration out of range")
+// Mul returns the duration d*v.
+// If the operation would overflow the maximum duration, or underflow the minimum duration, it panics.
+// Use CanMul to test whether a panic would occur.
+func (d Duration) Mul(v int64) Duration {
+	out, err := d.mul(v)
+	if err != nil {
+		panic(err.Error())
 	}
-	return Duration{v: *out}, nil
+	return out
+}
+
+// CanMul returns false if Mul would panic if passed the same argument.
+func (d Duration) CanMul(v int64) bool {
+	_, err := d.mul(v)
+	return err == nil
+}
+
+// MulChecked returns the duration d*v, in the same manner as Mul, but returns an error instead of panicking.
+func (d Duration) MulChecked(v int64) (Duration, error) {
+	return d.mul(v)
 }
 
 func (d Duration) mul(v int64) (Duration, error) {
 	out := new(big.Int).Set(&d.v)
-	out.Mul(out, big.NewInt(v))
+	return checkDuration(out.Mul(out, big.NewInt(v)))
+}
+
+// MulFloat returns the duration d*v, rounded to the nearest nanosecond.
+// If the operation would overflow the maximum duration, or underflow the minimum duration,
+// or if v is NaN or infinite, it panics.
+// Use CanMulFloat to test whether a panic would occur.
+func (d Duration) MulFloat(v float64) Duration {
+	out, err := d.mulFloat(v)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanMulFloat returns false if MulFloat would panic if passed the same argument.
+func (d Duration) CanMulFloat(v float64) bool {
+	_, err := d.mulFloat(v)
+	return err == nil
+}
+
+// MulFloatChecked returns the duration d*v, in the same manner as MulFloat, but returns an error instead of panicking.
+func (d Duration) MulFloatChecked(v float64) (Duration, error) {
+	return d.mulFloat(v)
+}
+
+func (d Duration) mulFloat(v float64) (Duration, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return Duration{}, fmt.Errorf("invalid multiplier")
+	}
+
+	f := new(big.Float).SetPrec(128).SetInt(&d.v)
+	f.Mul(f, big.NewFloat(v))
+	if f.Signbit() {
+		f.Sub(f, bigFloatHalf)
+	} else {
+		f.Add(f, bigFloatHalf)
+	}
+
+	out, _ := f.Int(nil)
+	return checkDuration(out)
+}
+
+// Div returns the duration d/v, truncated toward zero.
+// If v is 0, or if the operation would overflow the maximum duration, it panics.
+// Use CanDiv to test whether a panic would occur.
+func (d Duration) Div(v int64) Duration {
+	out, err := d.div(v)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanDiv returns false if Div would panic if passed the same argument.
+func (d Duration) CanDiv(v int64) bool {
+	_, err := d.div(v)
+	return err == nil
+}
+
+// DivChecked returns the duration d/v, in the same manner as Div, but returns an error instead of panicking.
+func (d Duration) DivChecked(v int64) (Duration, error) {
+	return d.div(v)
+}
+
+func (d Duration) div(v int64) (Duration, error) {
+	if v == 0 {
+		return Duration{}, fmt.Errorf("division by zero")
+	}
+	return checkDuration(new(big.Int).Quo(&d.v, big.NewInt(v)))
+}
+
+// Ratio returns the ratio of d to d2 as a floating point number.
+// If d2 is zero, the result is ±Inf, or NaN if d is also zero.
+func (d Duration) Ratio(d2 Duration) float64 {
+	if d2.v.Sign() == 0 {
+		if d.v.Sign() == 0 {
+			return math.NaN()
+		}
+		return math.Inf(d.v.Sign())
+	}
+
+	out, _ := new(big.Float).Quo(new(big.Float).SetInt(&d.v), new(big.Float).SetInt(&d2.v)).Float64()
+	return out
+}
+
+// Truncate returns the result of rounding d toward zero to a multiple of m.
+// If m <= 0, Truncate returns d unchanged.
+func (d Duration) Truncate(m Extent) Duration {
+	if m <= 0 {
+		return d
+	}
+
+	out := new(big.Int).Rem(&d.v, big.NewInt(int64(m)))
+	return Duration{v: *out.Sub(&d.v, out)}
+}
+
+// Round returns the result of rounding d to the nearest multiple of m.
+// The rounding behavior for halfway values is to round away from zero.
+// If m <= 0, Round returns d unchanged.
+// If the result would overflow the maximum duration, or underflow the minimum duration, it panics.
+// Use CanRound to test whether a panic would occur.
+func (d Duration) Round(m Extent) Duration {
+	out, err := d.round(m)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanRound returns false if Round would panic if passed the same argument.
+func (d Duration) CanRound(m Extent) bool {
+	_, err := d.round(m)
+	return err == nil
+}
+
+// RoundChecked returns the result of rounding d to a multiple of m, in the same manner as Round, but returns an error instead of panicking.
+func (d Duration) RoundChecked(m Extent) (Duration, error) {
+	return d.round(m)
+}
+
+func (d Duration) round(m Extent) (Duration, error) {
+	if m <= 0 {
+		return d, nil
+	}
+
+	mv := big.NewInt(int64(m))
+	rem := new(big.Int).Rem(&d.v, mv)
+	out := new(big.Int).Sub(&d.v, rem)
 
-	if out.Cmp(bigIntMinInt64) == -1 || out.Cmp(bigIntMaxInt64) == 1 {
-		return Duration{}, fmt.Errorf("duration out of range")
+	if twice := new(big.Int).Lsh(new(big.Int).Abs(rem), 1); twice.Cmp(mv) >= 0 {
+		if d.v.Sign() < 0 {
+			out.Sub(out, mv)
+		} else {
+			out.Add(out, mv)
+		}
 	}
-	return Duration{v: *out}, nil
+	return checkDuration(out)
+}
+
+func checkDuration(v *big.Int) (Duration, error) {
+	if v.Cmp(bigIntMinInt64) == -1 || v.Cmp(bigIntMaxInt64) == 1 {
+		return Duration{}, errOverflow("duration out of range")
+	}
+	return Duration{v: *v}, nil
 }
 
 // Nanoseconds returns the duration as a floating point number of nanoseconds.
@@ -238,7 +475,7 @@ func (d Duration) integers() (secs int64, nsec uint32, neg bool) {
 
 // Parse the time portion of an ISO 8601 duration.
 func (d *Duration) Parse(s string) error {
-	_, _, _, _, secs, nsec, neg, err := parseDuration(s, false, true)
+	_, secs, nsec, neg, err := parseDuration(s, false, true)
 	if err != nil {
 		return err
 	}
@@ -281,4 +518,6 @@ var (
 	bigFloatSecondExtent      = big.NewFloat(float64(Second))
 	bigFloatMinuteExtent      = big.NewFloat(float64(Minute))
 	bigFloatHourExtent        = big.NewFloat(float64(Hour))
+
+	bigFloatHalf = big.NewFloat(0.5)
 )
@@ -0,0 +1,200 @@
+package chrono
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+// ParseDurationConfig controls how calendar units are interpreted by ParseGoDuration and ParseNaturalDuration.
+type ParseDurationConfig struct {
+	// DayLength is the length of a day. If non-zero, days (and weeks, of 7 days each) are converted to a Duration
+	// of this length. If zero, days and weeks are instead returned as part of the Period, to be applied
+	// to a date according to the calendar. A negative day length is invalid.
+	DayLength Extent
+}
+
+// ParseGoDuration parses a string in the form accepted by the standard library's time.ParseDuration,
+// extended with the units d (days) and w (weeks), such as 90m, 1h30m, 2d or 1w3d.
+// Days and weeks are interpreted according to conf; all other units are returned as a Duration.
+func ParseGoDuration(s string, conf ParseDurationConfig) (Period, Duration, error) {
+	acc := durationAccumulator{conf: conf}
+	neg, err := scanGoDuration(s, func(whole, frac, unit string) error {
+		if _, ok := goDurationUnits[unit]; !ok && unit != "d" && unit != "w" {
+			return fmt.Errorf("unknown unit %q in duration %q", unit, s)
+		}
+		return acc.add(whole, frac, unit)
+	})
+	if err != nil {
+		return Period{}, Duration{}, err
+	}
+	return acc.result(neg)
+}
+
+// ParseNaturalDuration parses a duration written in English, such as "2 hours 30 minutes", "1 day, 3 hours and 5 seconds",
+// "1.5 weeks" or "a year and a day". Each value consists of a number (or "a" or "an"), followed by a unit,
+// and values can be separated by whitespace, commas, or the word "and". Parsing is case insensitive.
+//
+// The following units are accepted in their singular and plural forms, along with the abbreviations listed:
+// nanosecond (ns), microsecond (us, µs), millisecond (ms, msec), second (s, sec), minute (m, min), hour (h, hr),
+// day (d), week (w, wk), month (mo), and year (y, yr).
+// Years and months are always returned as part of the Period, days and weeks are interpreted according to conf,
+// and all other units are returned as a Duration. As in ISO 8601, only the smallest unit returned as part of the Period
+// may have a fraction, so "3.5 months and 2 years" is accepted, but "2 years, 3.5 months and 1 day" is not.
+func ParseNaturalDuration(s string, conf ParseDurationConfig) (Period, Duration, error) {
+	acc := durationAccumulator{conf: conf}
+
+	var number string
+	var haveUnit bool
+	for _, tok := range tokenizeNaturalDuration(strings.ToLower(s)) {
+		switch {
+		case tok == "," || tok == "and":
+			if number != "" {
+				return Period{}, Duration{}, fmt.Errorf("missing unit after %q in duration %q", number, s)
+			}
+		case number == "" && (tok == "a" || tok == "an"):
+			number = "1"
+		case number == "":
+			if tok[0] != '.' && (tok[0] < '0' || tok[0] > '9') {
+				return Period{}, Duration{}, fmt.Errorf("unexpected %q in duration %q, expecting number", tok, s)
+			}
+			number = tok
+		default:
+			unit, ok := naturalDurationUnits[tok]
+			if !ok {
+				return Period{}, Duration{}, fmt.Errorf("unknown unit %q in duration %q", tok, s)
+			}
+
+			whole, frac, _ := strings.Cut(number, ".")
+			if (whole == "" && frac == "") || strings.ContainsRune(frac, '.') {
+				return Period{}, Duration{}, fmt.Errorf("invalid number %q in duration %q", number, s)
+			}
+
+			if err := acc.add(whole, frac, unit); err != nil {
+				return Period{}, Duration{}, err
+			}
+			number, haveUnit = "", true
+		}
+	}
+
+	if number != "" {
+		return Period{}, Duration{}, fmt.Errorf("missing unit after %q in duration %q", number, s)
+	} else if !haveUnit {
+		return Period{}, Duration{}, fmt.Errorf("invalid duration %q", s)
+	}
+	return acc.result(false)
+}
+
+// tokenizeNaturalDuration splits s into numbers, words and individual punctuation characters, discarding whitespace.
+func tokenizeNaturalDuration(s string) []string {
+	var out []string
+	var start int
+	var prev rune // n = number, w = word
+
+	flush := func(i int) {
+		if start < i {
+			out = append(out, s[start:i])
+		}
+		start = i
+	}
+
+	for i, r := range s {
+		var typ rune
+		switch {
+		case (r >= '0' && r <= '9') || r == '.':
+			typ = 'n'
+		case unicode.IsLetter(r):
+			typ = 'w'
+		}
+
+		if typ != prev || typ == 0 {
+			flush(i)
+		}
+
+		if typ == 0 {
+			if !unicode.IsSpace(r) {
+				out = append(out, string(r))
+			}
+			start = i + len(string(r))
+		}
+		prev = typ
+	}
+	flush(len(s))
+	return out
+}
+
+var naturalDurationUnits = map[string]string{
+	"nanosecond": "ns", "nanoseconds": "ns", "ns": "ns",
+	"microsecond": "us", "microseconds": "us", "us": "us", "µs": "us", "μs": "us",
+	"millisecond": "ms", "milliseconds": "ms", "ms": "ms", "msec": "ms", "msecs": "ms",
+	"second": "s", "seconds": "s", "s": "s", "sec": "s", "secs": "s",
+	"minute": "m", "minutes": "m", "m": "m", "min": "m", "mins": "m",
+	"hour": "h", "hours": "h", "h": "h", "hr": "h", "hrs": "h",
+	"day": "d", "days": "d", "d": "d",
+	"week": "w", "weeks": "w", "w": "w", "wk": "w", "wks": "w",
+	"month": "mo", "months": "mo", "mo": "mo",
+	"year": "y", "years": "y", "y": "y", "yr": "y", "yrs": "y",
+}
+
+// durationAccumulator sums the values of a parsed duration, sorting them into a Period and a Duration.
+type durationAccumulator struct {
+	conf   ParseDurationConfig
+	period Period
+	v      big.Int
+}
+
+func (a *durationAccumulator) add(whole, frac, unit string) error {
+	var days int64
+	switch unit {
+	case "d":
+		days = 1
+	case "w":
+		days = 7
+	}
+
+	if days != 0 && a.conf.DayLength != 0 {
+		u, ok := mulInt64(days, int64(a.conf.DayLength))
+		if !ok || a.conf.DayLength < 0 {
+			return fmt.Errorf("invalid day length %s", a.conf.DayLength)
+		}
+		return addGoDurationValue(&a.v, whole, frac, u)
+	} else if u, ok := goDurationUnits[unit]; ok {
+		return addGoDurationValue(&a.v, whole, frac, u)
+	}
+
+	var u Unit
+	switch unit {
+	case "d":
+		u = UnitDay
+	case "w":
+		u = UnitWeek
+	case "mo":
+		u = UnitMonth
+	case "y":
+		u = UnitYear
+	}
+
+	v, err := periodOfDecimal(whole, frac, u)
+	if err != nil {
+		return err
+	}
+
+	a.period, err = a.period.add(v)
+	return err
+}
+
+func (a *durationAccumulator) result(neg bool) (Period, Duration, error) {
+	v := new(big.Int).Set(&a.v)
+	p := a.period
+	if neg {
+		v.Neg(v)
+		p = p.neg()
+	}
+
+	d, err := checkDuration(v)
+	if err != nil {
+		return Period{}, Duration{}, err
+	}
+	return p, d, nil
+}
@@ -0,0 +1,111 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestParseGoDuration(t *testing.T) {
+	day := chrono.ParseDurationConfig{DayLength: 24 * chrono.Hour}
+
+	for _, tt := range []struct {
+		input    string
+		conf     chrono.ParseDurationConfig
+		period   chrono.Period
+		duration chrono.Duration
+	}{
+		{"0", chrono.ParseDurationConfig{}, chrono.Period{}, chrono.Duration{}},
+		{"90m", chrono.ParseDurationConfig{}, chrono.Period{}, chrono.DurationOf(90 * chrono.Minute)},
+		{"1h30m", chrono.ParseDurationConfig{}, chrono.Period{}, chrono.DurationOf(90 * chrono.Minute)},
+		{"1.5h", chrono.ParseDurationConfig{}, chrono.Period{}, chrono.DurationOf(90 * chrono.Minute)},
+		{"2d", chrono.ParseDurationConfig{}, chrono.Period{Days: 2}, chrono.Duration{}},
+		{"1w3d12h", chrono.ParseDurationConfig{}, chrono.Period{Weeks: 1, Days: 3}, chrono.DurationOf(12 * chrono.Hour)},
+		{"-1w3d12h", chrono.ParseDurationConfig{}, chrono.Period{Weeks: -1, Days: -3}, chrono.DurationOf(-12 * chrono.Hour)},
+		{"1w3d12h", day, chrono.Period{}, chrono.DurationOf(252 * chrono.Hour)},
+		{"1.5d", day, chrono.Period{}, chrono.DurationOf(36 * chrono.Hour)},
+		{"2d", chrono.ParseDurationConfig{DayLength: 8 * chrono.Hour}, chrono.Period{}, chrono.DurationOf(16 * chrono.Hour)},
+		{"1500000h", chrono.ParseDurationConfig{}, chrono.Period{}, chrono.DurationOf(1500000 * chrono.Hour)},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			p, d, err := chrono.ParseGoDuration(tt.input, tt.conf)
+			if err != nil {
+				t.Fatalf("failed to parse duration: %v", err)
+			}
+
+			if !p.Equal(tt.period) {
+				t.Errorf("period = %s, want %s", p, tt.period)
+			}
+
+			if d.Compare(tt.duration) != 0 {
+				t.Errorf("duration = %s, want %s", d, tt.duration)
+			}
+		})
+	}
+
+	for _, tt := range []string{"", "-", "h", "1", "1y", "1mo", "1h 30m", "1..5h", "1d"} {
+		t.Run(tt, func(t *testing.T) {
+			if _, _, err := chrono.ParseGoDuration(tt, chrono.ParseDurationConfig{DayLength: -chrono.Hour}); err == nil {
+				t.Error("expecting error")
+			}
+		})
+	}
+	t.Run("fraction before smaller unit", func(t *testing.T) {
+		if _, _, err := chrono.ParseGoDuration("1.5w2d", chrono.ParseDurationConfig{}); err == nil {
+			t.Error("expecting error")
+		}
+	})
+}
+
+func TestParseNaturalDuration(t *testing.T) {
+	day := chrono.ParseDurationConfig{DayLength: 24 * chrono.Hour}
+
+	for _, tt := range []struct {
+		input    string
+		conf     chrono.ParseDurationConfig
+		period   chrono.Period
+		duration chrono.Duration
+	}{
+		{"2 hours 30 minutes", chrono.ParseDurationConfig{}, chrono.Period{}, chrono.DurationOf(150 * chrono.Minute)},
+		{"2h 30m", chrono.ParseDurationConfig{}, chrono.Period{}, chrono.DurationOf(150 * chrono.Minute)},
+		{"1 Hour, 5 mins and 10 secs", chrono.ParseDurationConfig{}, chrono.Period{}, chrono.DurationOf(chrono.Hour + 5*chrono.Minute + 10*chrono.Second)},
+		{"250 msec", chrono.ParseDurationConfig{}, chrono.Period{}, chrono.DurationOf(250 * chrono.Millisecond)},
+		{"1.5 weeks", chrono.ParseDurationConfig{}, chrono.Period{Weeks: 1, Fraction: 500000000, FractionUnit: chrono.UnitWeek}, chrono.Duration{}},
+		{"1.5 weeks", day, chrono.Period{}, chrono.DurationOf(252 * chrono.Hour)},
+		{"a year and a day", chrono.ParseDurationConfig{}, chrono.Period{Years: 1, Days: 1}, chrono.Duration{}},
+		{"a year and a day", day, chrono.Period{Years: 1}, chrono.DurationOf(24 * chrono.Hour)},
+		{"3 months, 2 wks", chrono.ParseDurationConfig{}, chrono.Period{Months: 3, Weeks: 2}, chrono.Duration{}},
+		{"an hr", chrono.ParseDurationConfig{}, chrono.Period{}, chrono.DurationOf(chrono.Hour)},
+		{"3.5 months and 2 years", chrono.ParseDurationConfig{}, chrono.Period{Years: 2, Months: 3, Fraction: 500000000, FractionUnit: chrono.UnitMonth}, chrono.Duration{}},
+		{"2 years 3.5 months 1 day", day, chrono.Period{Years: 2, Months: 3, Fraction: 500000000, FractionUnit: chrono.UnitMonth}, chrono.DurationOf(24 * chrono.Hour)},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			p, d, err := chrono.ParseNaturalDuration(tt.input, tt.conf)
+			if err != nil {
+				t.Fatalf("failed to parse duration: %v", err)
+			}
+
+			if !p.Equal(tt.period) {
+				t.Errorf("period = %s, want %s", p, tt.period)
+			}
+
+			if d.Compare(tt.duration) != 0 {
+				t.Errorf("duration = %s, want %s", d, tt.duration)
+			}
+
+			var pd chrono.PeriodDuration
+			if err := pd.Parse(chrono.PeriodDuration{Period: p, Duration: d}.String()); err != nil {
+				t.Errorf("failed to parse formatted duration: %v", err)
+			}
+		})
+	}
+
+	for _, tt := range []string{"", "and", "2", "hours", "2 fortnights", "2 and hours", "1.2.3 days", "2 hours -5 minutes",
+		"2 years 3.5 months 1 day", "1 day and 3.5 months", "1.5 years and 2.5 days"} {
+		t.Run(tt, func(t *testing.T) {
+			if _, _, err := chrono.ParseNaturalDuration(tt, chrono.ParseDurationConfig{}); err == nil {
+				t.Error("expecting error")
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package chrono_test
 
 import (
+	"math"
 	"reflect"
 	"runtime"
 	"strings"
@@ -407,3 +408,90 @@ func TestDuration_Units(t *testing.T) {
 		t.Errorf("expecting 7 nsecs, got %d", nsec)
 	}
 }
+
+func TestDuration_arithmetic(t *testing.T) {
+	d := chrono.DurationOf(90 * chrono.Minute)
+
+	for _, tt := range []struct {
+		name     string
+		f        func() chrono.Duration
+		can      func() bool
+		expected chrono.Duration
+	}{
+		{"sub", func() chrono.Duration { return d.Sub(chrono.DurationOf(2 * chrono.Hour)) }, func() bool { return d.CanSub(chrono.DurationOf(2 * chrono.Hour)) }, chrono.DurationOf(-30 * chrono.Minute)},
+		{"neg", d.Neg, d.CanNeg, chrono.DurationOf(-90 * chrono.Minute)},
+		{"abs", d.Neg().Abs, d.Neg().CanAbs, d},
+		{"mul", func() chrono.Duration { return d.Mul(-3) }, func() bool { return d.CanMul(-3) }, chrono.DurationOf(-270 * chrono.Minute)},
+		{"mul float", func() chrono.Duration { return d.MulFloat(1.5) }, func() bool { return d.CanMulFloat(1.5) }, chrono.DurationOf(135 * chrono.Minute)},
+		{"mul float rounds", func() chrono.Duration { return chrono.DurationOf(3).MulFloat(-0.5) }, func() bool { return true }, chrono.DurationOf(-2)},
+		{"div", func() chrono.Duration { return d.Div(4) }, func() bool { return d.CanDiv(4) }, chrono.DurationOf(22*chrono.Minute + 30*chrono.Second)},
+		{"div truncates", func() chrono.Duration { return chrono.DurationOf(-7).Div(2) }, func() bool { return true }, chrono.DurationOf(-3)},
+		{"truncate", func() chrono.Duration { return chrono.DurationOf(-100 * chrono.Minute).Truncate(chrono.Hour) }, func() bool { return true }, chrono.DurationOf(-chrono.Hour)},
+		{"truncate non-positive", func() chrono.Duration { return d.Truncate(0) }, func() bool { return true }, d},
+		{"round down", func() chrono.Duration { return chrono.DurationOf(89 * chrono.Minute).Round(chrono.Hour) }, func() bool { return true }, chrono.DurationOf(chrono.Hour)},
+		{"round halfway", func() chrono.Duration { return d.Round(chrono.Hour) }, func() bool { return d.CanRound(chrono.Hour) }, chrono.DurationOf(2 * chrono.Hour)},
+		{"round halfway negative", func() chrono.Duration { return d.Neg().Round(chrono.Hour) }, func() bool { return true }, chrono.DurationOf(-2 * chrono.Hour)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.can() {
+				t.Error("can = false, want true")
+			}
+
+			if out := tt.f(); out.Compare(tt.expected) != 0 {
+				t.Errorf("result = %s, want %s", out, tt.expected)
+			}
+		})
+	}
+
+	t.Run("overflow", func(t *testing.T) {
+		for _, tt := range []struct {
+			name string
+			can  bool
+			f    func()
+		}{
+			{"sub", chrono.MinDuration().CanSub(chrono.DurationOf(1)), func() { chrono.MinDuration().Sub(chrono.DurationOf(1)) }},
+			{"neg", chrono.MinDuration().CanNeg(), func() { chrono.MinDuration().Neg() }},
+			{"abs", chrono.MinDuration().CanAbs(), func() { chrono.MinDuration().Abs() }},
+			{"mul", chrono.MaxDuration().CanMul(2), func() { chrono.MaxDuration().Mul(2) }},
+			{"mul float", chrono.MaxDuration().CanMulFloat(1.5), func() { chrono.MaxDuration().MulFloat(1.5) }},
+			{"mul NaN", d.CanMulFloat(math.NaN()), func() { d.MulFloat(math.NaN()) }},
+			{"div by zero", d.CanDiv(0), func() { d.Div(0) }},
+			{"div min", chrono.MinDuration().CanDiv(-1), func() { chrono.MinDuration().Div(-1) }},
+			{"round", chrono.MaxDuration().CanRound(chrono.Hour), func() { chrono.MaxDuration().Round(chrono.Hour) }},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				if tt.can {
+					t.Error("can = true, want false")
+				}
+
+				defer func() {
+					if r := recover(); r == nil {
+						t.Error("expecting panic that didn't occur")
+					}
+				}()
+
+				tt.f()
+			})
+		}
+	})
+}
+
+func TestDuration_Ratio(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		d        chrono.Duration
+		d2       chrono.Duration
+		expected float64
+	}{
+		{"fraction", chrono.DurationOf(90 * chrono.Minute), chrono.DurationOf(chrono.Hour), 1.5},
+		{"negative", chrono.DurationOf(-30 * chrono.Minute), chrono.DurationOf(chrono.Hour), -0.5},
+		{"large", chrono.MaxDuration(), chrono.MaxDuration(), 1},
+		{"by zero", chrono.DurationOf(chrono.Hour), chrono.Duration{}, math.Inf(1)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.d.Ratio(tt.d2); out != tt.expected {
+				t.Errorf("d.Ratio(d2) = %f, want %f", out, tt.expected)
+			}
+		})
+	}
+}
@@ -5,3 +5,42 @@ import "errors"
 // ErrUnsupportedRepresentation indicates that the requested value
 // cannot be represented, or that the requested value is not present.
 var ErrUnsupportedRepresentation = errors.ErrUnsupported
+
+var (
+	// ErrOutOfRange indicates that a value, or the result of an operation, falls outside of the range
+	// that can be represented by its type, such as a date before 24th November -4713 (4714 BCE), or a time after 99:59:59.
+	ErrOutOfRange = errors.New("out of range")
+
+	// ErrInvalidDate indicates that a date does not exist in the calendar, such as 30th February.
+	ErrInvalidDate = errors.New("invalid date")
+
+	// ErrOverflow indicates that the result of an arithmetic operation on a Duration, Extent or Period
+	// cannot be represented by its type.
+	ErrOverflow = errors.New("overflow")
+)
+
+// wrappedError is an error that has its own message, but also matches the sentinel error err when using errors.Is.
+type wrappedError struct {
+	msg string
+	err error
+}
+
+func (e *wrappedError) Error() string {
+	return e.msg
+}
+
+func (e *wrappedError) Unwrap() error {
+	return e.err
+}
+
+func errOutOfRange(msg string) error {
+	return &wrappedError{msg: msg, err: ErrOutOfRange}
+}
+
+func errInvalidDate(msg string) error {
+	return &wrappedError{msg: msg, err: ErrInvalidDate}
+}
+
+func errOverflow(msg string) error {
+	return &wrappedError{msg: msg, err: ErrOverflow}
+}
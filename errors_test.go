@@ -0,0 +1,168 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestCheckedConstructors(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		if d, err := chrono.NewLocalDate(2020, chrono.February, 29); err != nil {
+			t.Errorf("NewLocalDate() error = %v", err)
+		} else if d != chrono.LocalDateOf(2020, chrono.February, 29) {
+			t.Errorf("NewLocalDate() = %s", d)
+		}
+
+		if d, err := chrono.NewLocalDateOfDayOfYear(2020, 60); err != nil {
+			t.Errorf("NewLocalDateOfDayOfYear() error = %v", err)
+		} else if d != chrono.LocalDateOf(2020, chrono.February, 29) {
+			t.Errorf("NewLocalDateOfDayOfYear() = %s", d)
+		}
+
+		if d, err := chrono.NewLocalDateOfFirstWeekday(2020, chrono.March, chrono.Monday); err != nil {
+			t.Errorf("NewLocalDateOfFirstWeekday() error = %v", err)
+		} else if d != chrono.LocalDateOf(2020, chrono.March, 2) {
+			t.Errorf("NewLocalDateOfFirstWeekday() = %s", d)
+		}
+
+		if tm, err := chrono.NewLocalTime(25, 30, 0, 0); err != nil {
+			t.Errorf("NewLocalTime() error = %v", err)
+		} else if tm.Compare(chrono.LocalTimeOf(25, 30, 0, 0)) != 0 {
+			t.Errorf("NewLocalTime() = %s", tm)
+		}
+
+		if tm, err := chrono.NewOffsetTime(12, 30, 0, 0, 2, 0); err != nil {
+			t.Errorf("NewOffsetTime() error = %v", err)
+		} else if tm.Compare(chrono.OffsetTimeOf(12, 30, 0, 0, 2, 0)) != 0 {
+			t.Errorf("NewOffsetTime() = %s", tm)
+		}
+
+		if dt, err := chrono.NewLocalDateTime(2020, chrono.March, 18, 12, 30, 0, 0); err != nil {
+			t.Errorf("NewLocalDateTime() error = %v", err)
+		} else if dt.Compare(chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 0, 0)) != 0 {
+			t.Errorf("NewLocalDateTime() = %s", dt)
+		}
+
+		if dt := chrono.LocalDateTimeOf(2020, chrono.April, 31, 0, 0, 0, 0); dt.Compare(chrono.LocalDateTimeOf(2020, chrono.May, 1, 0, 0, 0, 0)) != 0 {
+			t.Errorf("LocalDateTimeOf() = %s, want 2020-05-01 00:00:00", dt)
+		}
+
+		if dt := chrono.OffsetDateTimeOf(2020, chrono.April, 31, 0, 0, 0, 0, 2, 0); dt.String() != "2020-05-01 00:00:00+02:00" {
+			t.Errorf("OffsetDateTimeOf() = %s, want 2020-05-01 00:00:00+02:00", dt)
+		}
+
+		if dt, err := chrono.NewOffsetDateTime(2020, chrono.March, 18, 12, 30, 0, 0, 2, 0); err != nil {
+			t.Errorf("NewOffsetDateTime() error = %v", err)
+		} else if dt.String() != "2020-03-18 12:30:00+02:00" {
+			t.Errorf("NewOffsetDateTime() = %s", dt)
+		}
+	})
+
+	for _, tt := range []struct {
+		name     string
+		f        func() error
+		expected error
+	}{
+		{"NewLocalDate invalid", func() error { _, err := chrono.NewLocalDate(2021, chrono.February, 29); return err }, chrono.ErrInvalidDate},
+		{"NewLocalDate invalid month", func() error { _, err := chrono.NewLocalDate(2021, 13, 1); return err }, chrono.ErrInvalidDate},
+		{"NewLocalDate out of range", func() error { _, err := chrono.NewLocalDate(-5000, chrono.January, 1); return err }, chrono.ErrOutOfRange},
+		{"NewLocalDateOfDayOfYear invalid", func() error { _, err := chrono.NewLocalDateOfDayOfYear(2021, 366); return err }, chrono.ErrInvalidDate},
+		{"NewLocalDateOfFirstWeekday out of range", func() error {
+			_, err := chrono.NewLocalDateOfFirstWeekday(-4713, chrono.November, chrono.Monday)
+			return err
+		}, chrono.ErrOutOfRange},
+		{"NewLocalTime out of range", func() error { _, err := chrono.NewLocalTime(12, 60, 0, 0); return err }, chrono.ErrOutOfRange},
+		{"NewOffsetTime out of range", func() error { _, err := chrono.NewOffsetTime(100, 0, 0, 0, 0, 0); return err }, chrono.ErrOutOfRange},
+		{"NewLocalDateTime invalid", func() error { _, err := chrono.NewLocalDateTime(2020, chrono.April, 31, 0, 0, 0, 0); return err }, chrono.ErrInvalidDate},
+		{"NewOffsetDateTime invalid", func() error { _, err := chrono.NewOffsetDateTime(2020, chrono.April, 31, 0, 0, 0, 0, 2, 0); return err }, chrono.ErrInvalidDate},
+		{"NewOffsetDateTime out of range", func() error { _, err := chrono.NewOffsetDateTime(2020, chrono.April, 1, 0, 0, -1, 0, 0, 0); return err }, chrono.ErrOutOfRange},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.f(); !errors.Is(err, tt.expected) {
+				t.Errorf("error = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckedArithmetic(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		if d, err := chrono.LocalDateOf(2020, chrono.March, 18).AddDateChecked(0, 1, 1); err != nil {
+			t.Errorf("AddDateChecked() error = %v", err)
+		} else if d != chrono.LocalDateOf(2020, chrono.April, 19) {
+			t.Errorf("AddDateChecked() = %s", d)
+		}
+
+		if dt, err := chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0).AddChecked(chrono.DurationOf(13 * chrono.Hour)); err != nil {
+			t.Errorf("AddChecked() error = %v", err)
+		} else if dt.Compare(chrono.LocalDateTimeOf(2020, chrono.March, 19, 1, 0, 0, 0)) != 0 {
+			t.Errorf("AddChecked() = %s", dt)
+		}
+
+		if d, err := chrono.DurationOf(chrono.Hour).MulChecked(3); err != nil {
+			t.Errorf("MulChecked() error = %v", err)
+		} else if d.Compare(chrono.DurationOf(3*chrono.Hour)) != 0 {
+			t.Errorf("MulChecked() = %s", d)
+		}
+	})
+
+	for _, tt := range []struct {
+		name     string
+		f        func() error
+		expected error
+	}{
+		{"LocalDate.AddDateChecked", func() error { _, err := chrono.MaxLocalDate().AddDateChecked(0, 0, 1); return err }, chrono.ErrOutOfRange},
+		{"LocalTime.AddChecked", func() error { _, err := chrono.LocalTimeOf(99, 0, 0, 0).AddChecked(chrono.Hour); return err }, chrono.ErrOutOfRange},
+		{"OffsetTime.AddChecked", func() error { _, err := chrono.OffsetTimeOf(99, 0, 0, 0, 0, 0).AddChecked(chrono.Hour); return err }, chrono.ErrOutOfRange},
+		{"LocalDateTime.AddChecked", func() error {
+			_, err := chrono.MaxLocalDateTime().AddChecked(chrono.DurationOf(chrono.Nanosecond))
+			return err
+		}, chrono.ErrOutOfRange},
+		{"LocalDateTime.AddDateChecked", func() error { _, err := chrono.MinLocalDateTime().AddDateChecked(0, 0, -1); return err }, chrono.ErrOutOfRange},
+		{"OffsetDateTime.AddChecked", func() error {
+			_, err := chrono.MaxLocalDateTime().UTC().AddChecked(chrono.DurationOf(chrono.Nanosecond))
+			return err
+		}, chrono.ErrOutOfRange},
+		{"OffsetDateTime.AddDateChecked", func() error { _, err := chrono.MaxLocalDateTime().UTC().AddDateChecked(1, 0, 0); return err }, chrono.ErrOutOfRange},
+		{"Duration.AddChecked", func() error { _, err := chrono.MaxDuration().AddChecked(chrono.DurationOf(1)); return err }, chrono.ErrOverflow},
+		{"Duration.SubChecked", func() error { _, err := chrono.MinDuration().SubChecked(chrono.DurationOf(1)); return err }, chrono.ErrOverflow},
+		{"Duration.NegChecked", func() error { _, err := chrono.MinDuration().NegChecked(); return err }, chrono.ErrOverflow},
+		{"Duration.AbsChecked", func() error { _, err := chrono.MinDuration().AbsChecked(); return err }, chrono.ErrOverflow},
+		{"Duration.MulChecked", func() error { _, err := chrono.MaxDuration().MulChecked(2); return err }, chrono.ErrOverflow},
+		{"Duration.MulFloatChecked", func() error { _, err := chrono.MaxDuration().MulFloatChecked(1.5); return err }, chrono.ErrOverflow},
+		{"Duration.RoundChecked", func() error { _, err := chrono.MaxDuration().RoundChecked(chrono.Hour); return err }, chrono.ErrOverflow},
+		{"Extent.AddChecked", func() error { _, err := chrono.Extent(1 << 62).AddChecked(1 << 62); return err }, chrono.ErrOverflow},
+		{"Extent.MulChecked", func() error { _, err := chrono.Extent(1 << 62).MulChecked(2); return err }, chrono.ErrOverflow},
+		{"PeriodDuration.AddChecked", func() error {
+			pd := chrono.PeriodDuration{Duration: chrono.MaxDuration()}
+			_, err := pd.AddChecked(pd)
+			return err
+		}, chrono.ErrOverflow},
+		{"PeriodDuration.MulChecked", func() error {
+			_, err := chrono.PeriodDuration{Duration: chrono.MaxDuration()}.MulChecked(2)
+			return err
+		}, chrono.ErrOverflow},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.f(); !errors.Is(err, tt.expected) {
+				t.Errorf("error = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := chrono.DurationOf(chrono.Hour).DivChecked(0); err == nil {
+			t.Error("DivChecked(0) error = nil")
+		}
+
+		if _, err := (chrono.Period{Fraction: 500000000, FractionUnit: chrono.UnitDay}).AddChecked(chrono.Period{Fraction: 500000000, FractionUnit: chrono.UnitYear}); err == nil {
+			t.Error("Period.AddChecked() error = nil")
+		}
+
+		if _, err := (chrono.Period{Fraction: 500000000, FractionUnit: chrono.UnitDay}).SubChecked(chrono.Period{Fraction: 500000000, FractionUnit: chrono.UnitYear}); err == nil {
+			t.Error("Period.SubChecked() error = nil")
+		}
+	})
+}
@@ -50,3 +50,13 @@ func ExampleDuration_Format() {
 	fmt.Println(d.Format())
 	// Output: PT1H30M5S
 }
+
+func ExamplePeriodDuration_AddToOffsetDateTime() {
+	var pd chrono.PeriodDuration
+	_ = pd.Parse("P1M2DT12H")
+
+	d, _ := pd.AddToOffsetDateTime(chrono.OffsetDateTimeOf(2020, chrono.January, 30, 18, 0, 0, 0, 0, 0))
+
+	fmt.Println(d)
+	// Output: 2020-03-04 06:00:00Z
+}
@@ -16,3 +16,16 @@ func ExampleParseInterval() {
 	fmt.Printf("start: %v; duration: %v; end: %v; repetitions: %v", s, chrono.FormatDuration(p, d), e, i.Repetitions())
 	// Output: start: 2007-03-01 13:00:00Z; duration: P1Y2M10DT2H30M; end: 2008-05-11 15:30:00Z; repetitions: 5
 }
+
+func ExampleInterval_Split() {
+	i, _ := chrono.ParseInterval("2024-01-30T22:00:00Z/2024-02-02T03:00:00Z")
+
+	buckets, _ := i.Split(chrono.UnitMonth, chrono.UTC)
+	for _, b := range buckets {
+		_, d, _ := b.Interval.Duration()
+		fmt.Println(b.Label.Format(chrono.ISO8601DateTruncated), d)
+	}
+	// Output:
+	// 2024-01 PT26H
+	// 2024-02 PT27H
+}
@@ -3,6 +3,9 @@ package chrono
 import (
 	"fmt"
 	"math"
+	"math/big"
+	"strconv"
+	"strings"
 )
 
 // Extent represents a period of time measured in nanoseconds.
@@ -84,6 +87,103 @@ func truncateExtent(e, m int64) int64 {
 	return e - e%m
 }
 
+// Round returns the result of rounding e to the nearest multiple of m.
+// The rounding behavior for halfway values is to round away from zero.
+// If the result exceeds the maximum (or minimum) value that can be stored in an Extent,
+// Round returns the maximum (or minimum) extent. If m <= 0, Round returns e unchanged.
+func (e Extent) Round(m Extent) Extent {
+	if m <= 0 {
+		return e
+	}
+
+	r := e % m
+	if e < 0 {
+		r = -r
+		if r+r < m {
+			return e + r
+		}
+
+		if e1 := e - m + r; e1 < e {
+			return e1
+		}
+		return math.MinInt64
+	}
+
+	if r+r < m {
+		return e - r
+	}
+
+	if e1 := e + m - r; e1 > e {
+		return e1
+	}
+	return math.MaxInt64
+}
+
+// Abs returns the absolute value of e.
+// As a special case, the minimum extent is converted to the maximum extent.
+func (e Extent) Abs() Extent {
+	switch {
+	case e >= 0:
+		return e
+	case e == math.MinInt64:
+		return math.MaxInt64
+	default:
+		return -e
+	}
+}
+
+// Add returns the extent e+e2.
+// If the operation would overflow or underflow the range of Extent, it panics.
+// Use CanAdd to test whether a panic would occur.
+func (e Extent) Add(e2 Extent) Extent {
+	out, err := e.AddChecked(e2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanAdd returns false if Add would panic if passed the same argument.
+func (e Extent) CanAdd(e2 Extent) bool {
+	_, err := e.AddChecked(e2)
+	return err == nil
+}
+
+// AddChecked returns the extent e+e2, but returns an error matching ErrOverflow instead of panicking.
+func (e Extent) AddChecked(e2 Extent) (Extent, error) {
+	out, under, over := addInt64(int64(e), int64(e2))
+	if under || over {
+		return 0, errOverflow("extent out of range")
+	}
+	return Extent(out), nil
+}
+
+// Mul returns the extent e*v.
+// If the operation would overflow or underflow the range of Extent, it panics.
+// Use CanMul to test whether a panic would occur.
+func (e Extent) Mul(v int64) Extent {
+	out, err := e.MulChecked(v)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanMul returns false if Mul would panic if passed the same argument.
+func (e Extent) CanMul(v int64) bool {
+	_, err := e.MulChecked(v)
+	return err == nil
+}
+
+// MulChecked returns the extent e*v, but returns an error matching ErrOverflow instead of panicking.
+func (e Extent) MulChecked(v int64) (Extent, error) {
+	out, ok := mulInt64(int64(e), v)
+	if !ok {
+		return 0, errOverflow("extent out of range")
+	}
+	return Extent(out), nil
+}
+
 // String returns a string formatted according to ISO 8601.
 // It is equivalent to calling Format with no arguments.
 func (e Extent) String() string {
@@ -105,7 +205,7 @@ func (e Extent) Format(exclusive ...Designator) string {
 // Parse the time portion of an ISO 8601 duration.
 // Behaves the same as Duration.Parse.
 func (e *Extent) Parse(s string) error {
-	_, _, _, _, secs, nsec, neg, err := parseDuration(s, false, true)
+	_, secs, nsec, neg, err := parseDuration(s, false, true)
 	if err != nil {
 		return err
 	}
@@ -122,6 +222,42 @@ func (e *Extent) Parse(s string) error {
 	return nil
 }
 
+// FormatGo formats the extent in the same form as the standard library's time.Duration, e.g. 1h30m5.5s.
+// Leading zero units are omitted, and extents of less than one second are formatted
+// using a smaller unit (ms, µs, or ns) so that the leading digit is non-zero. The zero extent formats as 0s.
+func (e Extent) FormatGo() string {
+	return formatGoDuration(int64(e))
+}
+
+// ParseGo parses a string in the form accepted by the standard library's time.ParseDuration,
+// and stores the value it represents in e.
+// Such a string is a possibly signed sequence of decimal numbers, each with an optional fraction and a unit suffix,
+// such as 300ms, -1.5h or 2h45m. Valid units are ns, us (or µs), ms, s, m, and h.
+func (e *Extent) ParseGo(s string) error {
+	v := new(big.Int)
+	neg, err := scanGoDuration(s, func(whole, frac, unit string) error {
+		u, ok := goDurationUnits[unit]
+		if !ok {
+			return fmt.Errorf("unknown unit %q in duration %q", unit, s)
+		}
+		return addGoDurationValue(v, whole, frac, u)
+	})
+	if err != nil {
+		return err
+	}
+
+	if neg {
+		v.Neg(v)
+	}
+
+	if !v.IsInt64() {
+		return fmt.Errorf("invalid duration %q", s)
+	}
+
+	*e = Extent(v.Int64())
+	return nil
+}
+
 func extentAbs(e int64) int64 {
 	if e < 0 {
 		return e * -1
@@ -154,3 +290,145 @@ const (
 	maxSeconds  = int64(math.MaxInt64) / int64(Second)
 	maxPosNanos = int64(math.MaxInt64) % maxSeconds
 )
+
+var goDurationUnits = map[string]int64{
+	"ns": int64(Nanosecond),
+	"us": int64(Microsecond),
+	"µs": int64(Microsecond), // U+00B5 = micro symbol
+	"μs": int64(Microsecond), // U+03BC = Greek letter mu
+	"ms": int64(Millisecond),
+	"s":  int64(Second),
+	"m":  int64(Minute),
+	"h":  int64(Hour),
+}
+
+// scanGoDuration splits a Go-style duration string into its sign and a sequence of values,
+// calling f with the whole and fractional digits and the unit of each value in turn.
+func scanGoDuration(s string, f func(whole, frac, unit string) error) (neg bool, err error) {
+	orig := s
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	if s == "0" {
+		return false, nil
+	} else if s == "" {
+		return false, fmt.Errorf("invalid duration %q", orig)
+	}
+
+	for s != "" {
+		i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+		if i == -1 {
+			return false, fmt.Errorf("missing unit in duration %q", orig)
+		}
+		whole := s[:i]
+		s = s[i:]
+
+		var frac string
+		if s[0] == '.' {
+			s = s[1:]
+			if i = strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' }); i == -1 {
+				return false, fmt.Errorf("missing unit in duration %q", orig)
+			}
+			frac = s[:i]
+			s = s[i:]
+		}
+
+		if whole == "" && frac == "" {
+			return false, fmt.Errorf("invalid duration %q", orig)
+		}
+
+		i = strings.IndexFunc(s, func(r rune) bool { return r == '.' || (r >= '0' && r <= '9') })
+		if i == -1 {
+			i = len(s)
+		}
+
+		if i == 0 {
+			return false, fmt.Errorf("missing unit in duration %q", orig)
+		}
+
+		if err := f(whole, frac, s[:i]); err != nil {
+			return false, err
+		}
+		s = s[i:]
+	}
+	return neg, nil
+}
+
+// addGoDurationValue adds the value represented by the supplied whole and fractional digits, multiplied by unit, to v.
+// Any part of the fraction that is smaller than a nanosecond is truncated.
+func addGoDurationValue(v *big.Int, whole, frac string, unit int64) error {
+	u := big.NewInt(unit)
+	if whole != "" {
+		w, ok := new(big.Int).SetString(whole, 10)
+		if !ok {
+			return fmt.Errorf("invalid number %q", whole)
+		}
+		v.Add(v, w.Mul(w, u))
+	}
+
+	if frac != "" {
+		f, ok := new(big.Int).SetString(frac, 10)
+		if !ok {
+			return fmt.Errorf("invalid number %q", frac)
+		}
+
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(frac))), nil)
+		v.Add(v, f.Quo(f.Mul(f, u), scale))
+	}
+	return nil
+}
+
+func formatGoDuration(e int64) string {
+	if e == 0 {
+		return "0s"
+	}
+
+	var out string
+	if e < 0 {
+		out = "-"
+	}
+
+	u := uint64(e)
+	if e < 0 {
+		u = -u
+	}
+
+	if u < uint64(Second) {
+		switch {
+		case u < uint64(Microsecond):
+			return out + strconv.FormatUint(u, 10) + "ns"
+		case u < uint64(Millisecond):
+			return out + formatGoFraction(u, uint64(Microsecond)) + "µs"
+		default:
+			return out + formatGoFraction(u, uint64(Millisecond)) + "ms"
+		}
+	}
+
+	hours := u / uint64(Hour)
+	u -= hours * uint64(Hour)
+	mins := u / uint64(Minute)
+	u -= mins * uint64(Minute)
+
+	if hours != 0 {
+		out += strconv.FormatUint(hours, 10) + "h"
+	}
+
+	if hours != 0 || mins != 0 {
+		out += strconv.FormatUint(mins, 10) + "m"
+	}
+	return out + formatGoFraction(u, uint64(Second)) + "s"
+}
+
+// formatGoFraction formats v/unit as a decimal number, omitting trailing zeros of the fraction.
+func formatGoFraction(v, unit uint64) string {
+	out := strconv.FormatUint(v/unit, 10)
+	if rem := v % unit; rem != 0 {
+		digits := len(strconv.FormatUint(unit, 10)) - 1
+		frac := strconv.FormatUint(rem, 10)
+		frac = strings.Repeat("0", digits-len(frac)) + frac
+		out += "." + strings.TrimRight(frac, "0")
+	}
+	return out
+}
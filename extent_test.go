@@ -1,8 +1,10 @@
 package chrono_test
 
 import (
+	"math"
 	"strings"
 	"testing"
+	gotime "time"
 
 	"github.com/go-chrono/chrono"
 )
@@ -116,3 +118,134 @@ func TestExtent_Parse(t *testing.T) {
 		}
 	})
 }
+
+func TestExtent_Round(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		e    chrono.Extent
+		m    chrono.Extent
+	}{
+		{"round down", 1*chrono.Hour + 15*chrono.Minute, chrono.Hour},
+		{"halfway", 1*chrono.Hour + 30*chrono.Minute, chrono.Hour},
+		{"negative halfway", -1*chrono.Hour - 30*chrono.Minute, chrono.Hour},
+		{"sub-second", 1234567 * chrono.Nanosecond, chrono.Millisecond},
+		{"non-positive", 1234567 * chrono.Nanosecond, 0},
+		{"overflow", math.MaxInt64 - 5, 1000},
+		{"underflow", math.MinInt64 + 5, 1000},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := chrono.Extent(gotime.Duration(tt.e).Round(gotime.Duration(tt.m)))
+			if out := tt.e.Round(tt.m); out != expected {
+				t.Errorf("e.Round(%d) = %d, want %d", tt.m, out, expected)
+			}
+		})
+	}
+}
+
+func TestExtent_Abs(t *testing.T) {
+	for _, e := range []chrono.Extent{0, 5 * chrono.Second, -5 * chrono.Second, math.MinInt64, math.MaxInt64} {
+		expected := chrono.Extent(gotime.Duration(e).Abs())
+		if out := e.Abs(); out != expected {
+			t.Errorf("e.Abs() = %d, want %d", out, expected)
+		}
+	}
+}
+
+func TestExtent_arithmetic(t *testing.T) {
+	if out := (90 * chrono.Minute).Add(-30 * chrono.Minute); out != chrono.Hour {
+		t.Errorf("e.Add() = %d, want %d", out, chrono.Hour)
+	}
+
+	if out := (90 * chrono.Minute).Mul(-2); out != -3*chrono.Hour {
+		t.Errorf("e.Mul() = %d, want %d", out, -3*chrono.Hour)
+	}
+
+	for _, tt := range []struct {
+		name string
+		can  bool
+		f    func()
+	}{
+		{"add overflow", chrono.Extent(math.MaxInt64).CanAdd(1), func() { chrono.Extent(math.MaxInt64).Add(1) }},
+		{"add underflow", chrono.Extent(math.MinInt64).CanAdd(-1), func() { chrono.Extent(math.MinInt64).Add(-1) }},
+		{"mul overflow", chrono.Extent(math.MaxInt64 / 2).CanMul(3), func() { chrono.Extent(math.MaxInt64 / 2).Mul(3) }},
+		{"mul min by -1", chrono.Extent(math.MinInt64).CanMul(-1), func() { chrono.Extent(math.MinInt64).Mul(-1) }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.can {
+				t.Error("can = true, want false")
+			}
+
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expecting panic that didn't occur")
+				}
+			}()
+
+			tt.f()
+		})
+	}
+}
+
+func TestExtent_FormatGo(t *testing.T) {
+	for _, e := range []chrono.Extent{
+		0,
+		1,
+		999,
+		1500 * chrono.Nanosecond,
+		1100 * chrono.Microsecond,
+		chrono.Second,
+		90 * chrono.Minute,
+		1*chrono.Hour + 30*chrono.Minute + 5*chrono.Second + 500*chrono.Millisecond,
+		-2*chrono.Hour - 1,
+		math.MaxInt64,
+		math.MinInt64,
+	} {
+		expected := gotime.Duration(e).String()
+		t.Run(expected, func(t *testing.T) {
+			if out := e.FormatGo(); out != expected {
+				t.Errorf("e.FormatGo() = %s, want %s", out, expected)
+			}
+		})
+	}
+}
+
+func TestExtent_ParseGo(t *testing.T) {
+	for _, s := range []string{
+		"0",
+		"-0",
+		"5s",
+		"+5s",
+		"1h30m5.5s",
+		"1.5h",
+		".5m",
+		"1.s",
+		"300ms",
+		"-1.004µs",
+		"1μs",
+		"2us",
+		"3ns",
+		"1.0000000001s",
+		"2562047h47m16.854775807s",
+		"-2562047h47m16.854775808s",
+	} {
+		t.Run(s, func(t *testing.T) {
+			expected, _ := gotime.ParseDuration(s)
+
+			var e chrono.Extent
+			if err := e.ParseGo(s); err != nil {
+				t.Errorf("failed to parse extent: %v", err)
+			} else if e != chrono.Extent(expected) {
+				t.Errorf("parsed extent = %d, want %d", e, expected)
+			}
+		})
+	}
+
+	for _, s := range []string{"", "-", "5", ".s", "1d", "1h5", "2562047h47m16.854775808s"} {
+		t.Run("invalid "+s, func(t *testing.T) {
+			var e chrono.Extent
+			if err := e.ParseGo(s); err == nil {
+				t.Error("expecting error but got nil")
+			}
+		})
+	}
+}
@@ -107,6 +107,7 @@ const (
 	ISO8601DateSimple                = "%Y%m%d"                                  // 20060102
 	ISO8601DateExtended              = "%Y-%m-%d"                                // 2006-01-02
 	ISO8601DateTruncated             = "%Y-%m"                                   // 2006-01
+	ISO8601MonthDay                  = "--%m-%d"                                 // --01-02
 	ISO8601TimeSimple                = "T%H%M%S%z"                               // T030405-0700
 	ISO8601TimeExtended              = "T%H:%M:%S%Ez"                            // T03:04:05-07:00
 	ISO8601TimeMillisSimple          = "T%H%M%S.%3f%z"                           // T030405.000-0700
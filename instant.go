@@ -1,67 +1,135 @@
 package chrono
 
-import "strconv"
+import (
+	"math/big"
+	"strconv"
+)
 
-// Instant represents an instantaneous point in time with nanosecond resolution.
+// Instant represents an instantaneous point in time with nanosecond resolution, as read from a monotonic clock.
+// Instants are only meaningful relative to other instants read from the same clock, and are not affected by
+// adjustments to the system's wall clock. They should therefore be used to measure elapsed time.
+//
+// Instants are comparable values, and can be compared with ==.
+// The zero value represents an instant that has not been set, and can be detected using IsZero.
+// It is ordered before all instants returned by Now.
 type Instant struct {
-	v *int64
+	v int64
 }
 
 // Now returns the Instant that represents the current point in time.
 func Now() Instant {
-	now := monotime()
-	return Instant{
-		v: &now,
-	}
+	return Instant{v: monotime()}
+}
+
+// IsZero reports whether i is the zero value, which represents an instant that has not been set.
+func (i Instant) IsZero() bool {
+	return i.v == 0
 }
 
 // Compare compares i with i2. If i is before i2, it returns -1;
 // if i is after i2, it returns 1; if they're the same, it returns 0.
 func (i Instant) Compare(i2 Instant) int {
 	switch {
-	case i.v == nil:
-		panic("i is not initialized")
-	case i2.v == nil:
-		panic("i2 is not initialized")
-	case *i.v < *i2.v:
+	case i.v < i2.v:
 		return -1
-	case *i.v > *i2.v:
+	case i.v > i2.v:
 		return 1
 	default:
 		return 0
 	}
 }
 
+// Before reports whether i is before i2.
+func (i Instant) Before(i2 Instant) bool {
+	return i.v < i2.v
+}
+
+// After reports whether i is after i2.
+func (i Instant) After(i2 Instant) bool {
+	return i.v > i2.v
+}
+
+// Add returns the instant i+d.
+// If the result cannot be represented, it panics.
+// Use CanAdd to test whether a panic would occur.
+func (i Instant) Add(d Duration) Instant {
+	out, err := i.add(d)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanAdd returns false if Add would panic if passed the same argument.
+func (i Instant) CanAdd(d Duration) bool {
+	_, err := i.add(d)
+	return err == nil
+}
+
+// AddChecked returns the instant i+d, in the same manner as Add, but returns an error instead of panicking.
+func (i Instant) AddChecked(d Duration) (Instant, error) {
+	return i.add(d)
+}
+
+func (i Instant) add(d Duration) (Instant, error) {
+	out := big.NewInt(i.v)
+	if out.Add(out, &d.v); !out.IsInt64() {
+		return Instant{}, errOverflow("instant out of range")
+	}
+	return Instant{v: out.Int64()}, nil
+}
+
+// Sub returns the Duration that represents the elapsed time from i2 to i,
+// which is negative if i is before i2.
+func (i Instant) Sub(i2 Instant) Duration {
+	out := big.NewInt(i.v)
+	return Duration{v: *out.Sub(out, big.NewInt(i2.v))}
+}
+
 // Elapsed is shorthand for i.Until(chrono.Now()).
 func (i Instant) Elapsed() Duration {
 	return i.Until(Now())
 }
 
 func (i Instant) String() string {
-	return strconv.FormatInt(*i.v, 10)
+	return strconv.FormatInt(i.v, 10)
 }
 
-// Until returns the Duration that represents the elapsed time from i to v.
+// Until returns the Duration that represents the elapsed time from i to i2,
+// which is negative if i2 is before i. It is equivalent to i2.Sub(i).
 func (i Instant) Until(i2 Instant) Duration {
-	switch {
-	case i.v == nil:
-		panic("i is not initialized")
-	case i2.v == nil:
-		panic("i2 is not initialized")
-	}
-
-	iv, vv := *i.v, *i2.v
-	if vv < iv {
-		panic("v is smaller than i")
-	}
-
-	d := vv - iv
-	return DurationOf(Extent(d))
+	return i2.Sub(i)
 }
 
 // instant is used by the chronotest package.
 func instant(t int64) Instant {
-	return Instant{
-		v: &t,
-	}
+	return Instant{v: t}
+}
+
+// Reading pairs a monotonic reading of the current point in time with a reading of the wall clock that was taken
+// at the same moment. The instant should be used to measure elapsed time, and the wall clock to display the time of the reading.
+type Reading struct {
+	Instant Instant
+	Wall    OffsetDateTime
+}
+
+// ReadNow returns a Reading of the system's clocks, as described for Now and NowUTC.
+func ReadNow() Reading {
+	return ReadClock(SystemClock())
+}
+
+// ReadClock returns a Reading of the clocks provided by c.
+func ReadClock(c Clock) Reading {
+	return Reading{Instant: c.Now(), Wall: c.NowUTC()}
+}
+
+// Add returns the reading r with both its instant and wall clock reading advanced by d.
+// If the result cannot be represented, it panics.
+func (r Reading) Add(d Duration) Reading {
+	return Reading{Instant: r.Instant.Add(d), Wall: r.Wall.Add(d)}
+}
+
+// Sub returns the Duration that represents the elapsed time from r2 to r, as measured by their monotonic readings.
+func (r Reading) Sub(r2 Reading) Duration {
+	return r.Instant.Sub(r2.Instant)
 }
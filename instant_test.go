@@ -40,3 +40,63 @@ func TestInstant_Until(t *testing.T) {
 		t.Errorf("d.Nanoseconds() = %f, want 1e14", nsec)
 	}
 }
+
+func TestInstant_zero(t *testing.T) {
+	var i chrono.Instant
+	if !i.IsZero() {
+		t.Error("i.IsZero() = false, want true")
+	}
+
+	if now := chrono.Now(); now.IsZero() || !i.Before(now) {
+		t.Errorf("zero value should be before %s", now)
+	}
+
+	if str := i.String(); str != "0" {
+		t.Errorf("i.String() = %s, want 0", str)
+	}
+}
+
+func TestInstant_arithmetic(t *testing.T) {
+	i := chronotest.InstantOf(11e14)
+	i2 := i.Add(chrono.DurationOf(chrono.Hour))
+
+	if i2 != chronotest.InstantOf(11e14+int64(chrono.Hour)) {
+		t.Errorf("i.Add() = %s", i2)
+	}
+
+	if !i.Before(i2) || i.After(i2) || !i2.After(i) {
+		t.Error("incorrect ordering")
+	}
+
+	if d := i2.Sub(i); d.Compare(chrono.DurationOf(chrono.Hour)) != 0 {
+		t.Errorf("i2.Sub(i) = %s, want PT1H", d)
+	}
+
+	if d := i.Sub(i2); d.Compare(chrono.DurationOf(-chrono.Hour)) != 0 {
+		t.Errorf("i.Sub(i2) = %s, want -PT1H", d)
+	}
+
+	if d := i2.Until(i); d.Compare(chrono.DurationOf(-chrono.Hour)) != 0 {
+		t.Errorf("i2.Until(i) = %s, want -PT1H", d)
+	}
+
+	if i.CanAdd(chrono.MaxDuration()) {
+		t.Error("i.CanAdd(MaxDuration()) = true, want false")
+	}
+}
+
+func TestReadClock(t *testing.T) {
+	c := chronotest.NewClock(chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 0, 0))
+
+	r := chrono.ReadClock(c)
+	c.Advance(chrono.Minute)
+	r2 := chrono.ReadClock(c)
+
+	if d := r2.Sub(r); d.Compare(chrono.DurationOf(chrono.Minute)) != 0 {
+		t.Errorf("r2.Sub(r) = %s, want PT1M", d)
+	}
+
+	if expected := r.Add(chrono.DurationOf(chrono.Minute)); expected.Instant != r2.Instant || expected.Wall.String() != r2.Wall.String() {
+		t.Errorf("r.Add() = %v, want %v", expected, r2)
+	}
+}
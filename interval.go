@@ -2,23 +2,25 @@ package chrono
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 )
 
 // Interval represents the intervening time between two time points.
+//
+// When comparing and combining intervals (such as with Contains, Overlaps and Intersection),
+// intervals are half-open: an interval includes its start, but not its end.
+// Time points are compared by the instant that they represent, so the start and end of an interval may have different offsets.
+// Only the first occurrence of a repeating interval is considered, and an interval whose start or end
+// cannot be determined (i.e. only a duration is present) contains nothing and is unrelated to any other interval.
 type Interval struct {
 	s *OffsetDateTime
 	e *OffsetDateTime
-	d *periodDuration
+	d *PeriodDuration
 	r int
 }
 
-type periodDuration struct {
-	Period
-	Duration
-}
-
 // IntervalOfStartEnd creates an [Interval] from the provided start and end time points.
 func IntervalOfStartEnd(start, end OffsetDateTime, repetitions int) Interval {
 	return Interval{s: &start, e: &end, r: repetitions}
@@ -26,12 +28,12 @@ func IntervalOfStartEnd(start, end OffsetDateTime, repetitions int) Interval {
 
 // IntervalOfStartDuration creates an [Interval] from the provided start time point and duration.
 func IntervalOfStartDuration(start OffsetDateTime, period Period, duration Duration, repetitions int) Interval {
-	return Interval{s: &start, d: &periodDuration{Period: period, Duration: duration}, r: repetitions}
+	return Interval{s: &start, d: &PeriodDuration{Period: period, Duration: duration}, r: repetitions}
 }
 
 // IntervalOfDurationEnd creates an [Interval] from the provided duration and end time point.
 func IntervalOfDurationEnd(period Period, duration Duration, end OffsetDateTime, repetitions int) Interval {
-	return Interval{e: &end, d: &periodDuration{Period: period, Duration: duration}, r: repetitions}
+	return Interval{e: &end, d: &PeriodDuration{Period: period, Duration: duration}, r: repetitions}
 }
 
 // ParseInterval parses an ISO 8601 time interval, or a repeating time interval.
@@ -85,11 +87,11 @@ func (i Interval) string(sep string) string {
 	case i.s != nil && i.e != nil:
 		return out + i.s.Format(ISO8601) + sep + i.e.Format(ISO8601)
 	case i.s != nil && i.d != nil:
-		return out + i.s.Format(ISO8601) + sep + FormatDuration(i.d.Period, i.d.Duration)
+		return out + i.s.Format(ISO8601) + sep + i.d.String()
 	case i.d != nil && i.e != nil:
-		return out + FormatDuration(i.d.Period, i.d.Duration) + sep + i.e.Format(ISO8601)
+		return out + i.d.String() + sep + i.e.Format(ISO8601)
 	case i.d != nil:
-		return out + FormatDuration(i.d.Period, i.d.Duration)
+		return out + i.d.String()
 	default:
 		return out
 	}
@@ -99,16 +101,17 @@ func (i Interval) string(sep string) string {
 // by subtracting i.Duration() from i.End().
 // If neither are possible (i.e. only a duration is present),
 // [ErrUnsupportedRepresentation] is returned instead.
+// The calculation is performed by [PeriodDuration.AddToOffsetDateTime] in reverse.
 func (i Interval) Start() (OffsetDateTime, error) {
 	switch {
 	case i.s != nil:
 		return *i.s, nil
 	case i.e != nil:
-		d, err := i.d.mul(-1)
+		v, err := i.d.addToBigDate(i.e.v, true)
 		if err != nil {
 			return OffsetDateTime{}, err
 		}
-		return i.e.AddDate(-int(i.d.Years), -int(i.d.Months), -int(i.d.Days)).Add(d), nil
+		return OffsetDateTime{v: v, o: i.e.o}, nil
 	default:
 		return OffsetDateTime{}, ErrUnsupportedRepresentation
 	}
@@ -118,12 +121,13 @@ func (i Interval) Start() (OffsetDateTime, error) {
 // by adding i.Duration() to i.Start().
 // If neither are possible, (i.e. only a duration is present),
 // then [ErrUnsupportedRepresentation] is returned instead.
+// The calculation is performed by [PeriodDuration.AddToOffsetDateTime].
 func (i Interval) End() (OffsetDateTime, error) {
 	switch {
 	case i.e != nil:
 		return *i.e, nil
 	case i.s != nil:
-		return i.s.AddDate(int(i.d.Years), int(i.d.Months), int(i.d.Days)).Add(i.d.Duration), nil
+		return i.d.AddToOffsetDateTime(*i.s)
 	default:
 		return OffsetDateTime{}, ErrUnsupportedRepresentation
 	}
@@ -152,6 +156,391 @@ func (i Interval) Repetitions() int {
 	return i.r
 }
 
+// Occurrences returns an [IntervalIterator] that steps through the occurrences of i.
+// See [IntervalIterator] for the order in which occurrences are returned.
+func (i Interval) Occurrences() *IntervalIterator {
+	it := &IntervalIterator{remaining: i.Repetitions()}
+	if it.remaining == 0 {
+		it.remaining = 1
+	}
+
+	switch {
+	case i.s != nil && i.e != nil:
+		it.origin = *i.s
+		it.end = i.e
+		e := i.e.In(i.s.Offset())
+		it.pd.Duration = Duration{v: *new(big.Int).Sub(&e.v, &i.s.v)}
+	case i.s != nil:
+		it.origin = *i.s
+		it.pd = *i.d
+	case i.e != nil:
+		it.origin = *i.e
+		it.pd = *i.d
+		it.backwards = true
+	default:
+		it.err = ErrUnsupportedRepresentation
+	}
+	it.next = it.origin
+	return it
+}
+
+// IntervalIterator steps through the occurrences of a repeating [Interval].
+//
+// An interval with a repeat count of n (Rn) has n occurrences, and an interval without repetitions (or R0) has exactly one.
+// Unbounded intervals (R) have an unlimited number of occurrences, and only stop once the next occurrence
+// cannot be represented.
+//
+// For intervals that have a start (<start>/<end> and <start>/<duration>), each occurrence begins at the end of the previous one,
+// and occurrences are returned in chronological order.
+// For intervals of the form <duration>/<end>, each occurrence ends at the start of the previous one,
+// and occurrences are therefore returned in reverse chronological order, counting backwards from the end.
+//
+// The kth boundary between occurrences is found by multiplying the interval's duration by k and adding it to the start
+// (or subtracting it from the end) in the same manner as End and Start, rather than by repeatedly adding the duration
+// to the previous boundary. The period component is applied using AddDate, followed by the time component.
+// The first occurrence is therefore always [Start(), End()], and a monthly interval starting on 31st January 2020
+// continues with 2nd March, 31st March and 1st May, rather than drifting to the 2nd of every subsequent month.
+type IntervalIterator struct {
+	origin    OffsetDateTime
+	next      OffsetDateTime
+	k         int
+	end       *OffsetDateTime
+	pd        PeriodDuration
+	backwards bool
+	remaining int
+	err       error
+}
+
+// Next returns the start and end of the next occurrence.
+// If there are no more occurrences, or the next occurrence cannot be represented, ok is false.
+func (it *IntervalIterator) Next() (start, end OffsetDateTime, ok bool) {
+	if it.err != nil || it.remaining == 0 {
+		return OffsetDateTime{}, OffsetDateTime{}, false
+	}
+
+	var other OffsetDateTime
+	if it.end != nil {
+		// Subsequent boundaries are counted from the end of the first occurrence, retaining its offset.
+		other, it.end = *it.end, nil
+		it.origin, it.k = other, 0
+	} else {
+		pd, err := it.pd.mul(it.k + 1)
+		if err != nil {
+			it.err = err
+			return OffsetDateTime{}, OffsetDateTime{}, false
+		}
+
+		v, err := pd.addToBigDate(it.origin.v, it.backwards)
+		if err != nil {
+			it.err = err
+			return OffsetDateTime{}, OffsetDateTime{}, false
+		}
+		other = OffsetDateTime{v: v, o: it.origin.o}
+		it.k++
+	}
+
+	if it.remaining > 0 {
+		it.remaining--
+	}
+
+	if it.backwards {
+		start, end = other, it.next
+	} else {
+		start, end = it.next, other
+	}
+
+	it.next = other
+	return start, end, true
+}
+
+// Err returns the error that caused the iterator to stop before all occurrences were returned, if any.
+// This is [ErrUnsupportedRepresentation] if the interval consists only of a duration,
+// or an error describing the range violation if an occurrence cannot be represented.
+func (it *IntervalIterator) Err() error {
+	return it.err
+}
+
+// bounds returns the start and end of i, if both can be determined.
+func (i Interval) bounds() (start, end OffsetDateTime, ok bool) {
+	var err error
+	if start, err = i.Start(); err != nil {
+		return OffsetDateTime{}, OffsetDateTime{}, false
+	}
+
+	if end, err = i.End(); err != nil {
+		return OffsetDateTime{}, OffsetDateTime{}, false
+	}
+	return start, end, true
+}
+
+// Contains reports whether t lies within i, i.e. i.Start() <= t < i.End().
+func (i Interval) Contains(t OffsetDateTime) bool {
+	start, end, ok := i.bounds()
+	return ok && start.compareUTC(t) <= 0 && t.compareUTC(end) < 0
+}
+
+// ContainsInterval reports whether i2 lies entirely within i,
+// i.e. i.Start() <= i2.Start() and i2.End() <= i.End().
+func (i Interval) ContainsInterval(i2 Interval) bool {
+	start, end, ok := i.bounds()
+	start2, end2, ok2 := i2.bounds()
+	return ok && ok2 && start.compareUTC(start2) <= 0 && end2.compareUTC(end) <= 0
+}
+
+// Overlaps reports whether i and i2 have at least one time point in common.
+// Intervals that only share an endpoint do not overlap - see Abuts.
+func (i Interval) Overlaps(i2 Interval) bool {
+	start, end, ok := i.bounds()
+	start2, end2, ok2 := i2.bounds()
+	return ok && ok2 && start.compareUTC(end2) < 0 && start2.compareUTC(end) < 0
+}
+
+// Abuts reports whether i and i2 have no time points in common, but are adjacent,
+// i.e. either i ends where i2 starts, or i2 ends where i starts.
+func (i Interval) Abuts(i2 Interval) bool {
+	start, end, ok := i.bounds()
+	start2, end2, ok2 := i2.bounds()
+	return ok && ok2 && (end.compareUTC(start2) == 0 || end2.compareUTC(start) == 0)
+}
+
+// Intersection returns the interval that is common to both i and i2.
+// If the intervals do not overlap, ok is false.
+// The start and end of the returned interval retain the offsets of the intervals from which they are taken,
+// preferring i where both intervals share the same time point.
+func (i Interval) Intersection(i2 Interval) (_ Interval, ok bool) {
+	if !i.Overlaps(i2) {
+		return Interval{}, false
+	}
+
+	start, end, _ := i.bounds()
+	start2, end2, _ := i2.bounds()
+
+	if start2.compareUTC(start) > 0 {
+		start = start2
+	}
+
+	if end2.compareUTC(end) < 0 {
+		end = end2
+	}
+	return IntervalOfStartEnd(start, end, 0), true
+}
+
+// Union returns the interval that covers both i and i2.
+// If the intervals neither overlap nor abut, then the union cannot be represented by a single interval, and ok is false.
+// The start and end of the returned interval retain the offsets of the intervals from which they are taken,
+// preferring i where both intervals share the same time point.
+func (i Interval) Union(i2 Interval) (_ Interval, ok bool) {
+	if !i.Overlaps(i2) && !i.Abuts(i2) {
+		return Interval{}, false
+	}
+
+	start, end, _ := i.bounds()
+	start2, end2, _ := i2.bounds()
+
+	if start2.compareUTC(start) < 0 {
+		start = start2
+	}
+
+	if end2.compareUTC(end) > 0 {
+		end = end2
+	}
+	return IntervalOfStartEnd(start, end, 0), true
+}
+
+// Gap returns the interval between i and i2.
+// If the intervals overlap or abut, there is no gap between them, and ok is false.
+func (i Interval) Gap(i2 Interval) (_ Interval, ok bool) {
+	start, end, ok := i.bounds()
+	start2, end2, ok2 := i2.bounds()
+
+	switch {
+	case !ok || !ok2:
+		return Interval{}, false
+	case end.compareUTC(start2) < 0:
+		return IntervalOfStartEnd(end, start2, 0), true
+	case end2.compareUTC(start) < 0:
+		return IntervalOfStartEnd(end2, start, 0), true
+	default:
+		return Interval{}, false
+	}
+}
+
+// Relation returns the relation of i to i2 according to Allen's interval algebra.
+// If the start or end of either interval cannot be determined, [ErrUnsupportedRepresentation] is returned.
+func (i Interval) Relation(i2 Interval) (IntervalRelation, error) {
+	start, end, ok := i.bounds()
+	start2, end2, ok2 := i2.bounds()
+	if !ok || !ok2 {
+		return 0, ErrUnsupportedRepresentation
+	}
+
+	startStart := start.compareUTC(start2)
+	endEnd := end.compareUTC(end2)
+
+	switch endStart, startEnd := end.compareUTC(start2), start.compareUTC(end2); {
+	case startStart == 0 && endEnd == 0:
+		return IntervalEquals, nil
+	case endStart < 0:
+		return IntervalBefore, nil
+	case endStart == 0:
+		return IntervalMeets, nil
+	case startEnd > 0:
+		return IntervalAfter, nil
+	case startEnd == 0:
+		return IntervalMetBy, nil
+	}
+
+	switch {
+	case startStart == 0 && endEnd < 0:
+		return IntervalStarts, nil
+	case startStart == 0:
+		return IntervalStartedBy, nil
+	case endEnd == 0 && startStart > 0:
+		return IntervalFinishes, nil
+	case endEnd == 0:
+		return IntervalFinishedBy, nil
+	case startStart > 0 && endEnd < 0:
+		return IntervalDuring, nil
+	case startStart < 0 && endEnd > 0:
+		return IntervalContains, nil
+	case startStart < 0:
+		return IntervalOverlaps, nil
+	default:
+		return IntervalOverlappedBy, nil
+	}
+}
+
+// IntervalBucket is a piece of an [Interval] produced by Split or SplitEvery.
+type IntervalBucket struct {
+	// Label identifies the bucket to which the piece belongs.
+	Label LocalDate
+	// Interval is the part of the split interval that falls within the bucket.
+	Interval Interval
+}
+
+// Split divides i at each boundary of the supplied unit (midnight, Monday, the 1st of the month, etc.)
+// as observed in the supplied offset, and returns the pieces in chronological order.
+// Each piece is labelled with the first date of the unit in which it occurs, e.g. the 1st of the month for UnitMonth,
+// or the Monday of the ISO week for UnitWeek. The start and end of each piece are expressed in the supplied offset.
+//
+// If the start or end of i cannot be determined, [ErrUnsupportedRepresentation] is returned.
+// If i is empty, no pieces are returned.
+func (i Interval) Split(unit Unit, offset Offset) ([]IntervalBucket, error) {
+	start, end, ok := i.bounds()
+	if !ok {
+		return nil, ErrUnsupportedRepresentation
+	}
+
+	start, end = start.In(offset), end.In(offset)
+	date, _ := splitDateAndTime(start.v)
+
+	label, err := truncateDateToUnit(date, unit)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []IntervalBucket
+	for start.compareUTC(end) < 0 {
+		pieceEnd := end
+
+		next, err := addUnitsToDate(label, unit, 1)
+		if err == nil {
+			if boundary := (OffsetDateTime{v: makeDateTime(next, 0), o: int64(offset)}); boundary.compareUTC(end) < 0 {
+				pieceEnd = boundary
+			}
+		}
+
+		out = append(out, IntervalBucket{
+			Label:    LocalDate(label),
+			Interval: IntervalOfStartEnd(start, pieceEnd, 0),
+		})
+		start, label = pieceEnd, next
+	}
+	return out, nil
+}
+
+// SplitEvery divides i into consecutive pieces of the supplied duration, starting from i.Start(),
+// and returns them in chronological order. The last piece may be shorter than step.
+// Each piece is labelled with the date on which it starts in the supplied offset,
+// and its start and end are expressed in that offset.
+//
+// If the start or end of i cannot be determined, [ErrUnsupportedRepresentation] is returned.
+// If i is empty, no pieces are returned.
+func (i Interval) SplitEvery(step Duration, offset Offset) ([]IntervalBucket, error) {
+	if step.v.Sign() <= 0 {
+		return nil, fmt.Errorf("step must be positive")
+	}
+
+	start, end, ok := i.bounds()
+	if !ok {
+		return nil, ErrUnsupportedRepresentation
+	}
+
+	start, end = start.In(offset), end.In(offset)
+
+	var out []IntervalBucket
+	for start.compareUTC(end) < 0 {
+		pieceEnd := end
+		if v, err := addDurationToBigDate(start.v, step); err == nil {
+			if next := (OffsetDateTime{v: v, o: int64(offset)}); next.compareUTC(end) < 0 {
+				pieceEnd = next
+			}
+		}
+
+		date, _ := splitDateAndTime(start.v)
+		out = append(out, IntervalBucket{
+			Label:    LocalDate(date),
+			Interval: IntervalOfStartEnd(start, pieceEnd, 0),
+		})
+		start = pieceEnd
+	}
+	return out, nil
+}
+
+// IntervalRelation is one of the 13 relations between two intervals defined by Allen's interval algebra.
+// Each relation describes the first interval (x) with reference to the second (y).
+type IntervalRelation int
+
+// The relations of Allen's interval algebra.
+const (
+	IntervalBefore       IntervalRelation = iota + 1 // x ends before y starts.
+	IntervalMeets                                    // x ends where y starts.
+	IntervalOverlaps                                 // x starts before y, and ends within y.
+	IntervalStarts                                   // x starts with y, and ends before y.
+	IntervalDuring                                   // x starts after y, and ends before y.
+	IntervalFinishes                                 // x starts after y, and ends with y.
+	IntervalEquals                                   // x starts and ends with y.
+	IntervalFinishedBy                               // x starts before y, and ends with y.
+	IntervalContains                                 // x starts before y, and ends after y.
+	IntervalStartedBy                                // x starts with y, and ends after y.
+	IntervalOverlappedBy                             // x starts within y, and ends after y.
+	IntervalMetBy                                    // x starts where y ends.
+	IntervalAfter                                    // x starts after y ends.
+)
+
+func (r IntervalRelation) String() string {
+	if r < IntervalBefore || r > IntervalAfter {
+		return fmt.Sprintf("%%!IntervalRelation(%d)", r)
+	}
+	return intervalRelationNames[r-1]
+}
+
+var intervalRelationNames = [13]string{
+	IntervalBefore - 1:       "Before",
+	IntervalMeets - 1:        "Meets",
+	IntervalOverlaps - 1:     "Overlaps",
+	IntervalStarts - 1:       "Starts",
+	IntervalDuring - 1:       "During",
+	IntervalFinishes - 1:     "Finishes",
+	IntervalEquals - 1:       "Equals",
+	IntervalFinishedBy - 1:   "FinishedBy",
+	IntervalContains - 1:     "Contains",
+	IntervalStartedBy - 1:    "StartedBy",
+	IntervalOverlappedBy - 1: "OverlappedBy",
+	IntervalMetBy - 1:        "MetBy",
+	IntervalAfter - 1:        "After",
+}
+
 func cutAB(s, sepA, sepB string) (before, after string, found int) {
 	if i := strings.Index(s, sepA); i >= 0 {
 		return s[:i], s[i+len(sepA):], 1
@@ -161,7 +550,7 @@ func cutAB(s, sepA, sepB string) (before, after string, found int) {
 	return s, "", 0
 }
 
-func parseInterval(s string) (start, end *OffsetDateTime, pd *periodDuration, repeat int, err error) {
+func parseInterval(s string) (start, end *OffsetDateTime, pd *PeriodDuration, repeat int, err error) {
 	if len(s) == 0 {
 		return nil, nil, nil, 0, fmt.Errorf("empty string")
 	}
@@ -201,7 +590,7 @@ func parseInterval(s string) (start, end *OffsetDateTime, pd *periodDuration, re
 		if err != nil {
 			return nil, nil, nil, 0, err
 		}
-		pd = &periodDuration{p, d}
+		pd = &PeriodDuration{Period: p, Duration: d}
 	}
 
 	if s2 != "" && s2[0] >= '0' && s2[0] <= '9' { // <start>/<end> or <duration>/<end>
@@ -213,7 +602,7 @@ func parseInterval(s string) (start, end *OffsetDateTime, pd *periodDuration, re
 		if err != nil {
 			return nil, nil, nil, 0, err
 		}
-		pd = &periodDuration{p, d}
+		pd = &PeriodDuration{Period: p, Duration: d}
 	}
 
 	return start, end, pd, repeat, nil
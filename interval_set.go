@@ -0,0 +1,175 @@
+package chrono
+
+import (
+	"math/big"
+	"sort"
+)
+
+// IntervalSet is a set of time points, represented as a sorted list of intervals
+// that neither overlap nor abut each other.
+// Intervals are half-open, and compared in the same manner as described for [Interval].
+//
+// The zero value is an empty set. Operations on an IntervalSet never modify it, but instead return a new set.
+type IntervalSet struct {
+	spans []span
+}
+
+// span is a normalized interval with a determined start and end, where start is before end.
+type span struct {
+	start, end OffsetDateTime
+}
+
+// IntervalSetOf returns the IntervalSet that contains every time point in the supplied intervals.
+// Overlapping and abutting intervals are merged. Intervals that are empty (their end is not after their start),
+// or whose start or end cannot be determined, are ignored.
+func IntervalSetOf(intervals ...Interval) IntervalSet {
+	spans := make([]span, 0, len(intervals))
+	for _, i := range intervals {
+		if start, end, ok := i.bounds(); ok {
+			spans = append(spans, span{start: start, end: end})
+		}
+	}
+	return IntervalSet{spans: normalizeSpans(spans)}
+}
+
+func normalizeSpans(spans []span) []span {
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].start.compareUTC(spans[j].start) < 0
+	})
+
+	var out []span
+	for _, s := range spans {
+		switch {
+		case s.start.compareUTC(s.end) >= 0:
+			continue
+		case len(out) != 0 && s.start.compareUTC(out[len(out)-1].end) <= 0:
+			if last := &out[len(out)-1]; s.end.compareUTC(last.end) > 0 {
+				last.end = s.end
+			}
+		default:
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Len returns the number of intervals in s.
+func (s IntervalSet) Len() int {
+	return len(s.spans)
+}
+
+// IsEmpty reports whether s contains no time points.
+func (s IntervalSet) IsEmpty() bool {
+	return len(s.spans) == 0
+}
+
+// Intervals returns the intervals of s in chronological order.
+func (s IntervalSet) Intervals() []Interval {
+	out := make([]Interval, len(s.spans))
+	for i, sp := range s.spans {
+		out[i] = IntervalOfStartEnd(sp.start, sp.end, 0)
+	}
+	return out
+}
+
+// Contains reports whether t lies within any interval of s.
+// The lookup is performed using a binary search.
+func (s IntervalSet) Contains(t OffsetDateTime) bool {
+	i := sort.Search(len(s.spans), func(i int) bool {
+		return t.compareUTC(s.spans[i].end) < 0
+	})
+	return i < len(s.spans) && s.spans[i].start.compareUTC(t) <= 0
+}
+
+// Duration returns the total duration covered by the intervals of s.
+func (s IntervalSet) Duration() Duration {
+	out := new(big.Int)
+	for _, sp := range s.spans {
+		start, end := sp.start.utc(), sp.end.utc()
+		out.Add(out, end.Sub(&end, &start))
+	}
+	return Duration{v: *out}
+}
+
+// Union returns the set of time points that are in either s or s2.
+func (s IntervalSet) Union(s2 IntervalSet) IntervalSet {
+	spans := make([]span, 0, len(s.spans)+len(s2.spans))
+	spans = append(spans, s.spans...)
+	spans = append(spans, s2.spans...)
+	return IntervalSet{spans: normalizeSpans(spans)}
+}
+
+// Intersection returns the set of time points that are in both s and s2.
+func (s IntervalSet) Intersection(s2 IntervalSet) IntervalSet {
+	var out []span
+	for i, j := 0, 0; i < len(s.spans) && j < len(s2.spans); {
+		a, b := s.spans[i], s2.spans[j]
+
+		start := a.start
+		if b.start.compareUTC(start) > 0 {
+			start = b.start
+		}
+
+		end := a.end
+		if b.end.compareUTC(end) < 0 {
+			end = b.end
+		}
+
+		if start.compareUTC(end) < 0 {
+			out = append(out, span{start: start, end: end})
+		}
+
+		if a.end.compareUTC(b.end) < 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return IntervalSet{spans: out}
+}
+
+// Difference returns the set of time points that are in s, but not in s2.
+func (s IntervalSet) Difference(s2 IntervalSet) IntervalSet {
+	var out []span
+	var j int
+	for _, a := range s.spans {
+		start := a.start
+		for j < len(s2.spans) && s2.spans[j].end.compareUTC(start) <= 0 {
+			j++
+		}
+
+		for k := j; k < len(s2.spans) && s2.spans[k].start.compareUTC(a.end) < 0; k++ {
+			b := s2.spans[k]
+			if b.start.compareUTC(start) > 0 {
+				out = append(out, span{start: start, end: b.start})
+			}
+
+			if b.end.compareUTC(start) > 0 {
+				start = b.end
+			}
+		}
+
+		if start.compareUTC(a.end) < 0 {
+			out = append(out, span{start: start, end: a.end})
+		}
+	}
+	return IntervalSet{spans: out}
+}
+
+// Complement returns the set of time points that are within bounds, but not in s.
+// If the start or end of bounds cannot be determined, the returned set is empty.
+func (s IntervalSet) Complement(bounds Interval) IntervalSet {
+	return IntervalSetOf(bounds).Difference(s)
+}
+
+// String returns the intervals of s formatted as by [Interval.String], separated by commas and enclosed in braces.
+func (s IntervalSet) String() string {
+	out := "{"
+	for i, sp := range s.spans {
+		if i != 0 {
+			out += ", "
+		}
+		out += IntervalOfStartEnd(sp.start, sp.end, 0).String()
+	}
+	return out + "}"
+}
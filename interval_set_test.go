@@ -0,0 +1,141 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func intervalSetOf(t *testing.T, intervals ...string) chrono.IntervalSet {
+	t.Helper()
+
+	var in []chrono.Interval
+	for _, s := range intervals {
+		in = append(in, mustParseInterval(t, s))
+	}
+	return chrono.IntervalSetOf(in...)
+}
+
+func TestIntervalSetOf(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		intervals []string
+		expected  string
+	}{
+		{"empty", nil, "{}"},
+		{"duration only", []string{"PT1H"}, "{}"},
+		{
+			name:      "sorted",
+			intervals: []string{"2020-01-03T00:00:00Z/2020-01-04T00:00:00Z", "2020-01-01T00:00:00Z/2020-01-02T00:00:00Z"},
+			expected:  "{2020-01-01T00:00:00Z/2020-01-02T00:00:00Z, 2020-01-03T00:00:00Z/2020-01-04T00:00:00Z}",
+		},
+		{
+			name: "merged",
+			intervals: []string{
+				"2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+				"2020-01-01T00:00:00Z/2020-01-02T02:00:00+02:00",
+				"2020-01-02T12:00:00Z/PT1H",
+				"2020-01-05T00:00:00Z/2020-01-05T00:00:00Z",
+			},
+			expected: "{2020-01-01T00:00:00Z/2020-01-03T00:00:00Z}",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if s := intervalSetOf(t, tt.intervals...).String(); s != tt.expected {
+				t.Errorf("IntervalSetOf() = %s, want %s", s, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIntervalSet_Contains(t *testing.T) {
+	s := intervalSetOf(t,
+		"2020-01-01T00:00:00Z/2020-01-02T00:00:00Z",
+		"2020-01-03T00:00:00Z/2020-01-04T00:00:00Z",
+		"2020-01-05T00:00:00Z/2020-01-06T00:00:00Z",
+	)
+
+	for _, tt := range []struct {
+		t        chrono.OffsetDateTime
+		expected bool
+	}{
+		{chrono.OffsetDateTimeOf(2019, chrono.December, 31, 0, 0, 0, 0, 0, 0), false},
+		{chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 0, 0), true},
+		{chrono.OffsetDateTimeOf(2020, chrono.January, 2, 0, 0, 0, 0, 0, 0), false},
+		{chrono.OffsetDateTimeOf(2020, chrono.January, 3, 1, 0, 0, 0, 2, 0), false},
+		{chrono.OffsetDateTimeOf(2020, chrono.January, 3, 3, 0, 0, 0, 2, 0), true},
+		{chrono.OffsetDateTimeOf(2020, chrono.January, 5, 23, 59, 59, 0, 0, 0), true},
+		{chrono.OffsetDateTimeOf(2020, chrono.January, 6, 0, 0, 0, 0, 0, 0), false},
+	} {
+		t.Run(tt.t.String(), func(t *testing.T) {
+			if v := s.Contains(tt.t); v != tt.expected {
+				t.Errorf("s.Contains(%s) = %t, want %t", tt.t, v, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIntervalSet_operations(t *testing.T) {
+	s := intervalSetOf(t,
+		"2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+		"2020-01-05T00:00:00Z/2020-01-07T00:00:00Z",
+	)
+	s2 := intervalSetOf(t,
+		"2020-01-02T00:00:00Z/2020-01-06T00:00:00Z",
+		"2020-01-06T12:00:00Z/2020-01-08T00:00:00Z",
+	)
+
+	for _, tt := range []struct {
+		name     string
+		result   chrono.IntervalSet
+		expected string
+	}{
+		{
+			name:     "union",
+			result:   s.Union(s2),
+			expected: "{2020-01-01T00:00:00Z/2020-01-08T00:00:00Z}",
+		},
+		{
+			name:   "intersection",
+			result: s.Intersection(s2),
+			expected: "{2020-01-02T00:00:00Z/2020-01-03T00:00:00Z, 2020-01-05T00:00:00Z/2020-01-06T00:00:00Z, " +
+				"2020-01-06T12:00:00Z/2020-01-07T00:00:00Z}",
+		},
+		{
+			name:     "difference",
+			result:   s.Difference(s2),
+			expected: "{2020-01-01T00:00:00Z/2020-01-02T00:00:00Z, 2020-01-06T00:00:00Z/2020-01-06T12:00:00Z}",
+		},
+		{
+			name:     "reverse difference",
+			result:   s2.Difference(s),
+			expected: "{2020-01-03T00:00:00Z/2020-01-05T00:00:00Z, 2020-01-07T00:00:00Z/2020-01-08T00:00:00Z}",
+		},
+		{
+			name:     "complement",
+			result:   s.Complement(mustParseInterval(t, "2019-12-31T00:00:00Z/2020-01-06T00:00:00Z")),
+			expected: "{2019-12-31T00:00:00Z/2020-01-01T00:00:00Z, 2020-01-03T00:00:00Z/2020-01-05T00:00:00Z}",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.result.String(); out != tt.expected {
+				t.Errorf("result = %s, want %s", out, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIntervalSet_Duration(t *testing.T) {
+	s := intervalSetOf(t,
+		"2020-01-01T00:00:00Z/2020-01-01T02:00:00+01:00",
+		"2020-01-02T00:00:00Z/PT30M",
+	)
+
+	if d := s.Duration(); d.Compare(chrono.DurationOf(90*chrono.Minute)) != 0 {
+		t.Errorf("s.Duration() = %s, want PT1H30M", d)
+	}
+
+	if n := s.Len(); n != 2 {
+		t.Errorf("s.Len() = %d, want 2", n)
+	}
+}
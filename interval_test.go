@@ -104,3 +104,481 @@ func TestParseInterval(t *testing.T) {
 		}
 	}
 }
+
+func TestInterval_Occurrences(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		interval string
+		max      int
+		expected []string
+	}{
+		{
+			name:     "single",
+			interval: "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z",
+			max:      10,
+			expected: []string{"2007-03-01 13:00:00Z/2008-05-11 15:30:00Z"},
+		},
+		{
+			name:     "start duration",
+			interval: "R3/2020-01-31T00:00:00Z/P1M",
+			max:      10,
+			expected: []string{
+				"2020-01-31 00:00:00Z/2020-03-02 00:00:00Z",
+				"2020-03-02 00:00:00Z/2020-03-31 00:00:00Z",
+				"2020-03-31 00:00:00Z/2020-05-01 00:00:00Z",
+			},
+		},
+		{
+			name:     "duration end at month end",
+			interval: "R3/P1M/2021-05-31T00:00:00Z",
+			max:      10,
+			expected: []string{
+				"2021-05-01 00:00:00Z/2021-05-31 00:00:00Z",
+				"2021-03-31 00:00:00Z/2021-05-01 00:00:00Z",
+				"2021-03-03 00:00:00Z/2021-03-31 00:00:00Z",
+			},
+		},
+		{
+			name:     "start duration with weeks",
+			interval: "R2/2020-01-01T00:00:00Z/P1W1DT12H",
+			max:      10,
+			expected: []string{
+				"2020-01-01 00:00:00Z/2020-01-09 12:00:00Z",
+				"2020-01-09 12:00:00Z/2020-01-18 00:00:00Z",
+			},
+		},
+		{
+			name:     "duration end",
+			interval: "R2/P1DT1H/2020-01-10T00:00:00+02:00",
+			max:      10,
+			expected: []string{
+				"2020-01-08 23:00:00+02:00/2020-01-10 00:00:00+02:00",
+				"2020-01-07 22:00:00+02:00/2020-01-08 23:00:00+02:00",
+			},
+		},
+		{
+			name:     "unbounded start end with different offsets",
+			interval: "R/2020-01-01T00:00:00Z/2020-01-01T03:00:00+02:00",
+			max:      3,
+			expected: []string{
+				"2020-01-01 00:00:00Z/2020-01-01 03:00:00+02:00",
+				"2020-01-01 03:00:00+02:00/2020-01-01 04:00:00+02:00",
+				"2020-01-01 04:00:00+02:00/2020-01-01 05:00:00+02:00",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			i, err := chrono.ParseInterval(tt.interval)
+			if err != nil {
+				t.Fatalf("failed to parse interval: %v", err)
+			}
+
+			var out []string
+			it := i.Occurrences()
+			for len(out) < tt.max {
+				start, end, ok := it.Next()
+				if !ok {
+					break
+				}
+				out = append(out, start.String()+"/"+end.String())
+			}
+
+			if err := it.Err(); err != nil {
+				t.Errorf("it.Err() = %v, want nil", err)
+			}
+
+			if len(out) != len(tt.expected) {
+				t.Fatalf("got %d occurrences %v, want %d", len(out), out, len(tt.expected))
+			}
+
+			for j := range out {
+				if out[j] != tt.expected[j] {
+					t.Errorf("occurrence %d = %s, want %s", j, out[j], tt.expected[j])
+				}
+			}
+
+			start, _ := i.Start()
+			end, _ := i.End()
+			if first := start.String() + "/" + end.String(); out[0] != first {
+				t.Errorf("occurrence 0 = %s, want i.Start()/i.End() = %s", out[0], first)
+			}
+		})
+	}
+
+	t.Run("overflow", func(t *testing.T) {
+		start := chrono.OffsetDateTimeOf(5874000, chrono.January, 1, 0, 0, 0, 0, 0, 0)
+		it := chrono.IntervalOfStartDuration(start, chrono.Period{Years: 500}, chrono.Duration{}, -1).Occurrences()
+
+		var n int
+		for {
+			if _, _, ok := it.Next(); !ok {
+				break
+			}
+			n++
+		}
+
+		if n != 1 {
+			t.Errorf("got %d occurrences, want 1", n)
+		}
+
+		if it.Err() == nil {
+			t.Error("expecting error but got nil")
+		}
+	})
+
+	t.Run("duration only", func(t *testing.T) {
+		it := chrono.IntervalOfStartDuration(chrono.OffsetDateTime{}, chrono.Period{}, chrono.Duration{}, 0).Occurrences()
+		if _, _, ok := it.Next(); !ok {
+			t.Error("it.Next() = false, want true")
+		}
+
+		i, _ := chrono.ParseInterval("R5/P1D")
+		if it = i.Occurrences(); it.Err() != chrono.ErrUnsupportedRepresentation {
+			t.Errorf("it.Err() = %v, want %v", it.Err(), chrono.ErrUnsupportedRepresentation)
+		}
+
+		if _, _, ok := it.Next(); ok {
+			t.Error("it.Next() = true, want false")
+		}
+	})
+}
+
+func mustParseInterval(t *testing.T, s string) chrono.Interval {
+	t.Helper()
+
+	i, err := chrono.ParseInterval(s)
+	if err != nil {
+		t.Fatalf("failed to parse interval %s: %v", s, err)
+	}
+	return i
+}
+
+func TestInterval_Contains(t *testing.T) {
+	i := mustParseInterval(t, "2020-01-01T10:00:00Z/2020-01-01T14:00:00+02:00")
+
+	for _, tt := range []struct {
+		name     string
+		t        chrono.OffsetDateTime
+		expected bool
+	}{
+		{"before", chrono.OffsetDateTimeOf(2020, chrono.January, 1, 9, 59, 59, 0, 0, 0), false},
+		{"start", chrono.OffsetDateTimeOf(2020, chrono.January, 1, 10, 0, 0, 0, 0, 0), true},
+		{"start in other offset", chrono.OffsetDateTimeOf(2020, chrono.January, 1, 5, 0, 0, 0, -5, 0), true},
+		{"within", chrono.OffsetDateTimeOf(2020, chrono.January, 1, 11, 59, 59, 0, 0, 0), true},
+		{"end", chrono.OffsetDateTimeOf(2020, chrono.January, 1, 12, 0, 0, 0, 0, 0), false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if v := i.Contains(tt.t); v != tt.expected {
+				t.Errorf("i.Contains(%s) = %t, want %t", tt.t, v, tt.expected)
+			}
+		})
+	}
+
+	t.Run("duration only", func(t *testing.T) {
+		if mustParseInterval(t, "PT1H").Contains(chrono.OffsetDateTime{}) {
+			t.Error("i.Contains() = true, want false")
+		}
+	})
+}
+
+func TestInterval_algebra(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		i            string
+		i2           string
+		relation     chrono.IntervalRelation
+		contains     bool
+		overlaps     bool
+		abuts        bool
+		intersection string
+		union        string
+		gap          string
+	}{
+		{
+			name:     "before",
+			i:        "2020-01-01T00:00:00Z/2020-01-02T00:00:00Z",
+			i2:       "2020-01-03T00:00:00Z/2020-01-04T00:00:00Z",
+			relation: chrono.IntervalBefore,
+			gap:      "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+		},
+		{
+			name:     "after",
+			i:        "2020-01-03T00:00:00Z/2020-01-04T00:00:00Z",
+			i2:       "2020-01-01T00:00:00Z/2020-01-02T00:00:00Z",
+			relation: chrono.IntervalAfter,
+			gap:      "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+		},
+		{
+			name:     "meets with different offsets",
+			i:        "2020-01-01T00:00:00Z/2020-01-02T02:00:00+02:00",
+			i2:       "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+			relation: chrono.IntervalMeets,
+			abuts:    true,
+			union:    "2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+		},
+		{
+			name:     "met by",
+			i:        "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+			i2:       "2020-01-01T00:00:00Z/2020-01-02T00:00:00Z",
+			relation: chrono.IntervalMetBy,
+			abuts:    true,
+			union:    "2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+		},
+		{
+			name:         "overlaps",
+			i:            "2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+			i2:           "2020-01-02T00:00:00Z/2020-01-04T00:00:00Z",
+			relation:     chrono.IntervalOverlaps,
+			overlaps:     true,
+			intersection: "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+			union:        "2020-01-01T00:00:00Z/2020-01-04T00:00:00Z",
+		},
+		{
+			name:         "overlapped by",
+			i:            "2020-01-02T00:00:00Z/2020-01-04T00:00:00Z",
+			i2:           "2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+			relation:     chrono.IntervalOverlappedBy,
+			overlaps:     true,
+			intersection: "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+			union:        "2020-01-01T00:00:00Z/2020-01-04T00:00:00Z",
+		},
+		{
+			name:         "starts",
+			i:            "2020-01-01T00:00:00Z/2020-01-02T00:00:00Z",
+			i2:           "2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+			relation:     chrono.IntervalStarts,
+			overlaps:     true,
+			intersection: "2020-01-01T00:00:00Z/2020-01-02T00:00:00Z",
+			union:        "2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+		},
+		{
+			name:         "started by",
+			i:            "2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+			i2:           "2020-01-01T00:00:00Z/2020-01-02T00:00:00Z",
+			relation:     chrono.IntervalStartedBy,
+			contains:     true,
+			overlaps:     true,
+			intersection: "2020-01-01T00:00:00Z/2020-01-02T00:00:00Z",
+			union:        "2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+		},
+		{
+			name:         "during",
+			i:            "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+			i2:           "2020-01-01T00:00:00Z/2020-01-04T00:00:00Z",
+			relation:     chrono.IntervalDuring,
+			overlaps:     true,
+			intersection: "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+			union:        "2020-01-01T00:00:00Z/2020-01-04T00:00:00Z",
+		},
+		{
+			name:         "contains",
+			i:            "2020-01-01T00:00:00Z/2020-01-04T00:00:00Z",
+			i2:           "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+			relation:     chrono.IntervalContains,
+			contains:     true,
+			overlaps:     true,
+			intersection: "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+			union:        "2020-01-01T00:00:00Z/2020-01-04T00:00:00Z",
+		},
+		{
+			name:         "finishes",
+			i:            "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+			i2:           "2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+			relation:     chrono.IntervalFinishes,
+			overlaps:     true,
+			intersection: "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+			union:        "2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+		},
+		{
+			name:         "finished by",
+			i:            "2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+			i2:           "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+			relation:     chrono.IntervalFinishedBy,
+			contains:     true,
+			overlaps:     true,
+			intersection: "2020-01-02T00:00:00Z/2020-01-03T00:00:00Z",
+			union:        "2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+		},
+		{
+			name:         "equals with different offsets",
+			i:            "2020-01-01T00:00:00Z/2020-01-02T00:00:00Z",
+			i2:           "2020-01-01T01:00:00+01:00/2020-01-01T23:00:00-01:00",
+			relation:     chrono.IntervalEquals,
+			contains:     true,
+			overlaps:     true,
+			intersection: "2020-01-01T00:00:00Z/2020-01-02T00:00:00Z",
+			union:        "2020-01-01T00:00:00Z/2020-01-02T00:00:00Z",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			i, i2 := mustParseInterval(t, tt.i), mustParseInterval(t, tt.i2)
+
+			if r, err := i.Relation(i2); err != nil {
+				t.Errorf("i.Relation(i2) error = %v", err)
+			} else if r != tt.relation {
+				t.Errorf("i.Relation(i2) = %s, want %s", r, tt.relation)
+			}
+
+			if v := i.ContainsInterval(i2); v != tt.contains {
+				t.Errorf("i.ContainsInterval(i2) = %t, want %t", v, tt.contains)
+			}
+
+			if v := i.Overlaps(i2); v != tt.overlaps {
+				t.Errorf("i.Overlaps(i2) = %t, want %t", v, tt.overlaps)
+			}
+
+			if v := i.Abuts(i2); v != tt.abuts {
+				t.Errorf("i.Abuts(i2) = %t, want %t", v, tt.abuts)
+			}
+
+			for _, op := range []struct {
+				name     string
+				f        func(chrono.Interval, chrono.Interval) (chrono.Interval, bool)
+				expected string
+			}{
+				{"Intersection", chrono.Interval.Intersection, tt.intersection},
+				{"Union", chrono.Interval.Union, tt.union},
+				{"Gap", chrono.Interval.Gap, tt.gap},
+			} {
+				if v, ok := op.f(i, i2); ok != (op.expected != "") {
+					t.Errorf("i.%s(i2) ok = %t, want %t", op.name, ok, op.expected != "")
+				} else if ok && v.String() != op.expected {
+					t.Errorf("i.%s(i2) = %s, want %s", op.name, v, op.expected)
+				}
+			}
+		})
+	}
+
+	t.Run("duration only", func(t *testing.T) {
+		i := mustParseInterval(t, "PT1H")
+		if _, err := i.Relation(i); err != chrono.ErrUnsupportedRepresentation {
+			t.Errorf("i.Relation(i) error = %v, want %v", err, chrono.ErrUnsupportedRepresentation)
+		}
+	})
+}
+
+func TestInterval_Split(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		interval string
+		unit     chrono.Unit
+		offset   chrono.Offset
+		expected []string
+	}{
+		{
+			name:     "day",
+			interval: "2024-01-30T22:00:00Z/2024-02-02T03:00:00Z",
+			unit:     chrono.UnitDay,
+			offset:   chrono.UTC,
+			expected: []string{
+				"2024-01-30 2024-01-30T22:00:00Z/2024-01-31T00:00:00Z",
+				"2024-01-31 2024-01-31T00:00:00Z/2024-02-01T00:00:00Z",
+				"2024-02-01 2024-02-01T00:00:00Z/2024-02-02T00:00:00Z",
+				"2024-02-02 2024-02-02T00:00:00Z/2024-02-02T03:00:00Z",
+			},
+		},
+		{
+			name:     "day in offset",
+			interval: "2024-01-30T22:00:00Z/2024-02-01T00:00:00Z",
+			unit:     chrono.UnitDay,
+			offset:   chrono.OffsetOf(3, 0),
+			expected: []string{
+				"2024-01-31 2024-01-31T01:00:00+03:00/2024-02-01T00:00:00+03:00",
+				"2024-02-01 2024-02-01T00:00:00+03:00/2024-02-01T03:00:00+03:00",
+			},
+		},
+		{
+			name:     "week",
+			interval: "2024-01-30T22:00:00Z/2024-02-06T00:00:00Z",
+			unit:     chrono.UnitWeek,
+			offset:   chrono.UTC,
+			expected: []string{
+				"2024-01-29 2024-01-30T22:00:00Z/2024-02-05T00:00:00Z",
+				"2024-02-05 2024-02-05T00:00:00Z/2024-02-06T00:00:00Z",
+			},
+		},
+		{
+			name:     "month",
+			interval: "2024-01-30T22:00:00Z/2024-02-02T03:00:00Z",
+			unit:     chrono.UnitMonth,
+			offset:   chrono.UTC,
+			expected: []string{
+				"2024-01-01 2024-01-30T22:00:00Z/2024-02-01T00:00:00Z",
+				"2024-02-01 2024-02-01T00:00:00Z/2024-02-02T03:00:00Z",
+			},
+		},
+		{
+			name:     "quarter",
+			interval: "2024-02-15T00:00:00Z/2024-07-01T00:00:00Z",
+			unit:     chrono.UnitQuarter,
+			offset:   chrono.UTC,
+			expected: []string{
+				"2024-01-01 2024-02-15T00:00:00Z/2024-04-01T00:00:00Z",
+				"2024-04-01 2024-04-01T00:00:00Z/2024-07-01T00:00:00Z",
+			},
+		},
+		{
+			name:     "year",
+			interval: "2023-12-31T23:00:00-02:00/P1D",
+			unit:     chrono.UnitYear,
+			offset:   chrono.OffsetOf(-2, 0),
+			expected: []string{
+				"2023-01-01 2023-12-31T23:00:00-02:00/2024-01-01T00:00:00-02:00",
+				"2024-01-01 2024-01-01T00:00:00-02:00/2024-01-01T23:00:00-02:00",
+			},
+		},
+		{
+			name:     "empty",
+			interval: "2024-01-30T22:00:00Z/2024-01-30T22:00:00Z",
+			unit:     chrono.UnitDay,
+			offset:   chrono.UTC,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			buckets, err := mustParseInterval(t, tt.interval).Split(tt.unit, tt.offset)
+			if err != nil {
+				t.Fatalf("i.Split() error = %v", err)
+			}
+			checkIntervalBuckets(t, buckets, tt.expected)
+		})
+	}
+
+	t.Run("duration only", func(t *testing.T) {
+		if _, err := mustParseInterval(t, "P1D").Split(chrono.UnitDay, chrono.UTC); err != chrono.ErrUnsupportedRepresentation {
+			t.Errorf("i.Split() error = %v, want %v", err, chrono.ErrUnsupportedRepresentation)
+		}
+	})
+}
+
+func TestInterval_SplitEvery(t *testing.T) {
+	i := mustParseInterval(t, "2024-01-30T22:00:00Z/2024-01-31T03:00:00Z")
+
+	buckets, err := i.SplitEvery(chrono.DurationOf(2*chrono.Hour), chrono.OffsetOf(1, 0))
+	if err != nil {
+		t.Fatalf("i.SplitEvery() error = %v", err)
+	}
+
+	checkIntervalBuckets(t, buckets, []string{
+		"2024-01-30 2024-01-30T23:00:00+01:00/2024-01-31T01:00:00+01:00",
+		"2024-01-31 2024-01-31T01:00:00+01:00/2024-01-31T03:00:00+01:00",
+		"2024-01-31 2024-01-31T03:00:00+01:00/2024-01-31T04:00:00+01:00",
+	})
+
+	if _, err := i.SplitEvery(chrono.Duration{}, chrono.UTC); err == nil {
+		t.Error("expecting error but got nil")
+	}
+}
+
+func checkIntervalBuckets(t *testing.T, buckets []chrono.IntervalBucket, expected []string) {
+	t.Helper()
+
+	if len(buckets) != len(expected) {
+		t.Fatalf("got %d buckets %v, want %d", len(buckets), buckets, len(expected))
+	}
+
+	for j, b := range buckets {
+		if out := b.Label.String() + " " + b.Interval.String(); out != expected[j] {
+			t.Errorf("bucket %d = %s, want %s", j, out, expected[j])
+		}
+	}
+}
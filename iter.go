@@ -0,0 +1,67 @@
+//go:build go1.23
+
+package chrono
+
+import "iter"
+
+// All returns an iterator over the start and end of each occurrence of i.
+// Occurrences are produced in the same order as [Interval.Occurrences].
+// Iteration stops early if the next occurrence cannot be represented.
+func (i Interval) All() iter.Seq2[OffsetDateTime, OffsetDateTime] {
+	return func(yield func(OffsetDateTime, OffsetDateTime) bool) {
+		it := i.Occurrences()
+		for {
+			start, end, ok := it.Next()
+			if !ok || !yield(start, end) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the intervals of s in chronological order.
+func (s IntervalSet) All() iter.Seq[Interval] {
+	return func(yield func(Interval) bool) {
+		for _, sp := range s.spans {
+			if !yield(IntervalOfStartEnd(sp.start, sp.end, 0)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the dates of r in chronological order.
+func (r LocalDateRange) All() iter.Seq[LocalDate] {
+	return func(yield func(LocalDate) bool) {
+		it := r.Iterator()
+		for d, ok := it.Next(); ok && yield(d); d, ok = it.Next() {
+		}
+	}
+}
+
+// Backward returns an iterator over the dates of r in reverse chronological order.
+func (r LocalDateRange) Backward() iter.Seq[LocalDate] {
+	return func(yield func(LocalDate) bool) {
+		it := r.ReverseIterator()
+		for d, ok := it.Next(); ok && yield(d); d, ok = it.Next() {
+		}
+	}
+}
+
+// All returns an iterator over the datetimes of r in chronological order.
+func (r LocalDateTimeRange) All() iter.Seq[LocalDateTime] {
+	return func(yield func(LocalDateTime) bool) {
+		it := r.Iterator()
+		for d, ok := it.Next(); ok && yield(d); d, ok = it.Next() {
+		}
+	}
+}
+
+// Backward returns an iterator over the datetimes of r in reverse chronological order.
+func (r LocalDateTimeRange) Backward() iter.Seq[LocalDateTime] {
+	return func(yield func(LocalDateTime) bool) {
+		it := r.ReverseIterator()
+		for d, ok := it.Next(); ok && yield(d); d, ok = it.Next() {
+		}
+	}
+}
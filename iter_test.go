@@ -0,0 +1,108 @@
+//go:build go1.23
+
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestInterval_All(t *testing.T) {
+	i, err := chrono.ParseInterval("R/2020-01-01T00:00:00Z/PT1H")
+	if err != nil {
+		t.Fatalf("failed to parse interval: %v", err)
+	}
+
+	var out []string
+	for start, end := range i.All() {
+		out = append(out, start.String()+"/"+end.String())
+		if len(out) == 2 {
+			break
+		}
+	}
+
+	expected := []string{
+		"2020-01-01 00:00:00Z/2020-01-01 01:00:00Z",
+		"2020-01-01 01:00:00Z/2020-01-01 02:00:00Z",
+	}
+
+	if len(out) != len(expected) {
+		t.Fatalf("got %d occurrences %v, want %d", len(out), out, len(expected))
+	}
+
+	for j := range out {
+		if out[j] != expected[j] {
+			t.Errorf("occurrence %d = %s, want %s", j, out[j], expected[j])
+		}
+	}
+}
+
+func TestIntervalSet_All(t *testing.T) {
+	s := chrono.IntervalSetOf(
+		chrono.IntervalOfStartDuration(chrono.OffsetDateTimeOf(2020, chrono.January, 3, 0, 0, 0, 0, 0, 0), chrono.Period{Days: 1}, chrono.Duration{}, 0),
+		chrono.IntervalOfStartDuration(chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 0, 0), chrono.Period{Days: 1}, chrono.Duration{}, 0),
+	)
+
+	var out []string
+	for i := range s.All() {
+		out = append(out, i.String())
+	}
+
+	expected := []string{
+		"2020-01-01T00:00:00Z/2020-01-02T00:00:00Z",
+		"2020-01-03T00:00:00Z/2020-01-04T00:00:00Z",
+	}
+
+	if len(out) != len(expected) {
+		t.Fatalf("got %d intervals %v, want %d", len(out), out, len(expected))
+	}
+
+	for j := range out {
+		if out[j] != expected[j] {
+			t.Errorf("interval %d = %s, want %s", j, out[j], expected[j])
+		}
+	}
+}
+
+func TestLocalDateRange_All(t *testing.T) {
+	r, err := chrono.NewLocalDateRangeInclusive(chrono.LocalDateOf(2021, chrono.January, 31), chrono.LocalDateOf(2021, chrono.April, 30), chrono.Period{Months: 1})
+	if err != nil {
+		t.Fatalf("failed to create range: %v", err)
+	}
+
+	var out []string
+	for d := range r.All() {
+		out = append(out, d.String())
+	}
+	checkStrings(t, out, []string{"2021-01-31", "2021-02-28", "2021-03-31", "2021-04-30"})
+
+	out = nil
+	for d := range r.Backward() {
+		out = append(out, d.String())
+		if len(out) == 2 {
+			break
+		}
+	}
+	checkStrings(t, out, []string{"2021-04-30", "2021-03-31"})
+}
+
+func TestLocalDateTimeRange_All(t *testing.T) {
+	r, err := chrono.NewLocalDateTimeRange(chrono.LocalDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0), chrono.LocalDateTimeOf(2021, chrono.January, 1, 3, 0, 0, 0),
+		chrono.PeriodDuration{Duration: chrono.DurationOf(chrono.Hour)})
+	if err != nil {
+		t.Fatalf("failed to create range: %v", err)
+	}
+
+	var out []string
+	for d := range r.All() {
+		out = append(out, d.String())
+	}
+	checkStrings(t, out, []string{"2021-01-01 00:00:00", "2021-01-01 01:00:00", "2021-01-01 02:00:00"})
+
+	out = nil
+	for d := range r.Backward() {
+		out = append(out, d.String())
+	}
+	checkStrings(t, out, []string{"2021-01-01 02:00:00", "2021-01-01 01:00:00", "2021-01-01 00:00:00"})
+}
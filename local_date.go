@@ -22,17 +22,24 @@ type LocalDate int32
 // This function panics if the provided date would overflow the internal type,
 // or if it is earlier than the first date that can be represented by this type - 24th November -4713 (4714 BCE).
 func LocalDateOf(year int, month Month, day int) LocalDate {
-	if !isDateValid(year, int(month), day) {
-		panic("invalid date")
-	}
-
-	out, err := makeDate(year, int(month), day)
+	out, err := makeValidDate(year, int(month), day)
 	if err != nil {
 		panic(err.Error())
 	}
 	return LocalDate(out)
 }
 
+// NewLocalDate returns the LocalDate that represents the specified year, month and day, in the same manner as LocalDateOf.
+// Instead of panicking, it returns an error matching ErrInvalidDate if the date does not exist in the calendar,
+// or ErrOutOfRange if the date cannot be represented.
+func NewLocalDate(year int, month Month, day int) (LocalDate, error) {
+	out, err := makeValidDate(year, int(month), day)
+	if err != nil {
+		return 0, err
+	}
+	return LocalDate(out), nil
+}
+
 // OfDayOfYear returns the LocalDate that represents the specified day of the year.
 // This function panics if the provided date would overflow the internal type,
 // or if it is earlier than the first date that can be represented by this type - 24th November -4713 (4714 BCE).
@@ -44,6 +51,17 @@ func OfDayOfYear(year, day int) LocalDate {
 	return LocalDate(d)
 }
 
+// NewLocalDateOfDayOfYear returns the LocalDate that represents the specified day of the year, in the same manner as OfDayOfYear.
+// Instead of panicking, it returns an error matching ErrInvalidDate if the day does not exist in the year,
+// or ErrOutOfRange if the date cannot be represented.
+func NewLocalDateOfDayOfYear(year, day int) (LocalDate, error) {
+	d, err := ofDayOfYear(year, day)
+	if err != nil {
+		return 0, err
+	}
+	return LocalDate(d), nil
+}
+
 // OfFirstWeekday returns the LocalDate that represents the first of the specified weekday of the supplied month and year.
 // This function panics if the provided date would overflow the internal type,
 // or if it earlier than the first date that can be represented by this type - 24th November -4713 (4714 BCE).
@@ -51,6 +69,25 @@ func OfDayOfYear(year, day int) LocalDate {
 // By providing January as the month, the result is therefore also the first specified weekday of the year.
 // And by adding increments of 7 to the result, it is therefore possible to find the nth instance of a particular weekday.
 func OfFirstWeekday(year int, month Month, weekday Weekday) LocalDate {
+	v, err := ofFirstWeekday(year, month, weekday)
+	if err != nil {
+		panic(err.Error())
+	}
+	return LocalDate(v)
+}
+
+// NewLocalDateOfFirstWeekday returns the LocalDate that represents the first of the specified weekday
+// of the supplied month and year, in the same manner as OfFirstWeekday.
+// Instead of panicking, it returns an error matching ErrOutOfRange if the date cannot be represented.
+func NewLocalDateOfFirstWeekday(year int, month Month, weekday Weekday) (LocalDate, error) {
+	v, err := ofFirstWeekday(year, month, weekday)
+	if err != nil {
+		return 0, err
+	}
+	return LocalDate(v), nil
+}
+
+func ofFirstWeekday(year int, month Month, weekday Weekday) (int64, error) {
 	v := makeJDN(int64(year), int64(month), 1)
 	wd := (v + unixEpochJDN) % 7
 
@@ -61,9 +98,9 @@ func OfFirstWeekday(year int, month Month, weekday Weekday) LocalDate {
 	}
 
 	if v < minJDN || v > maxJDN {
-		panic("invalid date")
+		return 0, errOutOfRange("invalid date")
 	}
-	return LocalDate(v)
+	return v, nil
 }
 
 // OfISOWeek returns the LocalDate that represents the supplied ISO 8601 year, week number, and weekday.
@@ -113,6 +150,44 @@ func (d LocalDate) ISOWeek() (isoYear, isoWeek int) {
 	return
 }
 
+// Year returns the year specified by d.
+func (d LocalDate) Year() int {
+	year, _, _ := d.Date()
+	return year
+}
+
+// Month returns the month of the year specified by d.
+func (d LocalDate) Month() Month {
+	_, month, _ := d.Date()
+	return month
+}
+
+// Day returns the day of the month specified by d.
+func (d LocalDate) Day() int {
+	_, _, day := d.Date()
+	return day
+}
+
+// WithYear returns d with its year replaced.
+// If the day does not exist in the month of the new year (29th February in a non-leap year), it is clamped to the last day of the month.
+// This function panics if the resulting date cannot be represented.
+func (d LocalDate) WithYear(year int) LocalDate {
+	return LocalDate(mustDate(withYearMonth(int64(d), year, int(d.Month()))))
+}
+
+// WithMonth returns d with its month replaced.
+// If the day does not exist in the new month, such as the 30th February, it is clamped to the last day of the month.
+// This function panics if month is not valid, or if the resulting date cannot be represented.
+func (d LocalDate) WithMonth(month Month) LocalDate {
+	return LocalDate(mustDate(withYearMonth(int64(d), d.Year(), int(month))))
+}
+
+// WithDay returns d with its day of the month replaced.
+// Unlike WithYear and WithMonth, the day is not clamped: this function panics if the day does not exist in the month of d.
+func (d LocalDate) WithDay(day int) LocalDate {
+	return LocalDate(mustDate(withDay(int64(d), day)))
+}
+
 // AddDate returns the date corresponding to adding the given number of years, months, and days to d.
 func (d LocalDate) AddDate(years, months, days int) LocalDate {
 	out, err := addDateToDate(int64(d), years, months, days)
@@ -128,6 +203,87 @@ func (d LocalDate) CanAddDate(years, months, days int) bool {
 	return err == nil
 }
 
+// AddDateChecked returns the date corresponding to adding the given number of years, months, and days to d.
+// Instead of panicking, it returns an error matching ErrOutOfRange if the resulting date cannot be represented.
+func (d LocalDate) AddDateChecked(years, months, days int) (LocalDate, error) {
+	out, err := addDateToDate(int64(d), years, months, days)
+	if err != nil {
+		return 0, err
+	}
+	return LocalDate(out), nil
+}
+
+// Plus returns the date corresponding to adding n of the unit u to d, which must be UnitDay or a larger unit.
+// Units of months or longer are added in the same manner as AddDate.
+// This function panics if u is not supported, or if the resulting date cannot be represented.
+func (d LocalDate) Plus(n int64, u Unit) LocalDate {
+	if _, ok := u.extent(); ok && u != UnitDay {
+		panic(errUnsupportedUnit(u).Error())
+	}
+
+	out, err := plusBigDate(makeDateTime(int64(d), 0), n, u)
+	if err != nil {
+		panic(err.Error())
+	}
+	date, _ := splitDateAndTime(out)
+	return LocalDate(date)
+}
+
+// Minus returns the date corresponding to subtracting n of the unit u from d, in the same manner as Plus.
+func (d LocalDate) Minus(n int64, u Unit) LocalDate {
+	return d.Plus(-n, u)
+}
+
+// Until returns the number of whole units u from d until d2, which is negative if d2 is before d.
+// As with Plus, u must be UnitDay or a larger unit, and months and larger units are counted in the same manner as BetweenDates.
+// This function panics if u is not supported.
+func (d LocalDate) Until(d2 LocalDate, u Unit) int64 {
+	if _, ok := u.extent(); ok && u != UnitDay {
+		panic(errUnsupportedUnit(u).Error())
+	}
+
+	out, err := untilBigDate(makeDateTime(int64(d), 0), makeDateTime(int64(d2), 0), u)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// With returns the date returned by applying adj to d.
+func (d LocalDate) With(adj Adjuster) LocalDate {
+	return adj(d)
+}
+
+// BetweenDates returns the period between the dates a and b, such that a.AddDate(years, months, days) reproduces b,
+// where years, months and days are the components of the period. The period never contains weeks, and each of
+// its components has the same sign, being negative if b is before a.
+//
+// The number of whole months is maximized, taking into account that AddDate normalizes dates that overflow
+// the end of a month. As a result, there are 0 months and 28 days between 31st January and 28th February 2021,
+// since adding 1 month to 31st January produces 3rd March.
+func BetweenDates(a, b LocalDate) Period {
+	years, months, days, err := betweenDates(int64(a), int64(b))
+	if err != nil {
+		panic(err.Error())
+	}
+	return Period{Years: years, Months: months, Days: days}
+}
+
+// MonthsBetween returns the number of whole months between the dates a and b,
+// which is negative if b is before a. Months are counted in the same manner as BetweenDates.
+func MonthsBetween(a, b LocalDate) int {
+	years, months, _, err := betweenDates(int64(a), int64(b))
+	if err != nil {
+		panic(err.Error())
+	}
+	return years*12 + months
+}
+
+// WeeksBetween returns the number of whole weeks between the dates a and b, which is negative if b is before a.
+func WeeksBetween(a, b LocalDate) int {
+	return int((int64(b) - int64(a)) / 7)
+}
+
 func (d LocalDate) String() string {
 	year, month, day := d.Date()
 	return simpleDateStr(year, int(month), day)
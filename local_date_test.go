@@ -235,6 +235,8 @@ func TestLocalDate_AddDate(t *testing.T) {
 		{"time package example", chrono.LocalDateOf(2011, chrono.January, 1), -1, 2, 3, chrono.LocalDateOf(2010, chrono.March, 4)},
 		{"normalized time package example", chrono.LocalDateOf(2011, chrono.October, 31), 0, 1, 0, chrono.LocalDateOf(2011, chrono.December, 1)},
 		{"wrap around day", chrono.LocalDateOf(2020, chrono.March, 18), 0, 0, 20, chrono.LocalDateOf(2020, chrono.April, 7)},
+		{"wrap around year", chrono.LocalDateOf(2020, chrono.January, 31), 0, 14, 0, chrono.LocalDateOf(2021, chrono.March, 31)},
+		{"wrap around years", chrono.LocalDateOf(2020, chrono.January, 31), 0, 38, 0, chrono.LocalDateOf(2023, chrono.March, 31)},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			if ok := tt.date.CanAddDate(tt.addYears, tt.addMonths, tt.addDays); !ok {
@@ -272,3 +274,107 @@ func TestLocalDate_AddDate(t *testing.T) {
 		})
 	}
 }
+
+func TestBetweenDates(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		a, b     chrono.LocalDate
+		expected chrono.Period
+		months   int
+		weeks    int
+	}{
+		{"same date", chrono.LocalDateOf(2020, chrono.March, 18), chrono.LocalDateOf(2020, chrono.March, 18), chrono.Period{}, 0, 0},
+		{"forwards", chrono.LocalDateOf(2020, chrono.March, 18), chrono.LocalDateOf(2021, chrono.June, 20), chrono.Period{Years: 1, Months: 3, Days: 2}, 15, 65},
+		{"backwards", chrono.LocalDateOf(2021, chrono.June, 20), chrono.LocalDateOf(2020, chrono.March, 18), chrono.Period{Years: -1, Months: -3, Days: -2}, -15, -65},
+		{"day before", chrono.LocalDateOf(2020, chrono.March, 18), chrono.LocalDateOf(2020, chrono.April, 17), chrono.Period{Days: 30}, 0, 4},
+		{"end of month", chrono.LocalDateOf(2021, chrono.January, 31), chrono.LocalDateOf(2021, chrono.February, 28), chrono.Period{Days: 28}, 0, 4},
+		{"end of month overflow", chrono.LocalDateOf(2021, chrono.January, 31), chrono.LocalDateOf(2021, chrono.March, 3), chrono.Period{Months: 1}, 1, 4},
+		{"end of month backwards", chrono.LocalDateOf(2021, chrono.March, 31), chrono.LocalDateOf(2021, chrono.February, 28), chrono.Period{Months: -1, Days: -3}, -1, -4},
+		{"leap day", chrono.LocalDateOf(2020, chrono.February, 29), chrono.LocalDateOf(2021, chrono.February, 28), chrono.Period{Months: 11, Days: 30}, 11, 52},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if p := chrono.BetweenDates(tt.a, tt.b); !p.Equal(tt.expected) {
+				t.Errorf("BetweenDates(%s, %s) = %s, want %s", tt.a, tt.b, p, tt.expected)
+			}
+
+			if months := chrono.MonthsBetween(tt.a, tt.b); months != tt.months {
+				t.Errorf("MonthsBetween(%s, %s) = %d, want %d", tt.a, tt.b, months, tt.months)
+			}
+
+			if weeks := chrono.WeeksBetween(tt.a, tt.b); weeks != tt.weeks {
+				t.Errorf("WeeksBetween(%s, %s) = %d, want %d", tt.a, tt.b, weeks, tt.weeks)
+			}
+		})
+	}
+
+	t.Run("backwards string", func(t *testing.T) {
+		p := chrono.BetweenDates(chrono.LocalDateOf(2021, chrono.June, 20), chrono.LocalDateOf(2020, chrono.March, 18))
+		if out, expected := p.String(), "-P1Y3M2D"; out != expected {
+			t.Errorf("p.String() = %s, want %s", out, expected)
+		}
+
+		var p2 chrono.Period
+		if err := p2.Parse(p.String()); err != nil {
+			t.Errorf("failed to parse period: %v", err)
+		} else if !p2.Equal(p) {
+			t.Errorf("parsed period = %s, want %s", p2, p)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		start := chrono.LocalDateOf(2019, chrono.December, 25)
+		for i := 0; i < 500; i++ {
+			for _, j := range []int{-400, -59, -31, -1, 1, 28, 29, 30, 31, 62, 400} {
+				a, b := start+chrono.LocalDate(i), start+chrono.LocalDate(i+j)
+
+				p := chrono.BetweenDates(a, b)
+				if out := a.AddDate(int(p.Years), int(p.Months), int(p.Days)); out != b {
+					t.Fatalf("BetweenDates(%s, %s) = %s, but AddDate produces %s", a, b, p, out)
+				}
+			}
+		}
+	})
+}
+
+func TestLocalDate_fields(t *testing.T) {
+	d := chrono.LocalDateOf(2020, chrono.January, 31)
+	if d.Year() != 2020 || d.Month() != chrono.January || d.Day() != 31 {
+		t.Errorf("fields = %d, %s, %d", d.Year(), d.Month(), d.Day())
+	}
+
+	for _, tt := range []struct {
+		name     string
+		out      chrono.LocalDate
+		expected chrono.LocalDate
+	}{
+		{"WithYear", d.WithYear(1999), chrono.LocalDateOf(1999, chrono.January, 31)},
+		{"WithYear clamped", chrono.LocalDateOf(2020, chrono.February, 29).WithYear(2021), chrono.LocalDateOf(2021, chrono.February, 28)},
+		{"WithMonth", d.WithMonth(chrono.March), chrono.LocalDateOf(2020, chrono.March, 31)},
+		{"WithMonth clamped", d.WithMonth(chrono.February), chrono.LocalDateOf(2020, chrono.February, 29)},
+		{"WithDay", d.WithDay(1), chrono.LocalDateOf(2020, chrono.January, 1)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.out != tt.expected {
+				t.Errorf("out = %s, want %s", tt.out, tt.expected)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name string
+		f    func()
+	}{
+		{"WithDay invalid", func() { chrono.LocalDateOf(2021, chrono.February, 1).WithDay(29) }},
+		{"WithMonth invalid", func() { d.WithMonth(13) }},
+		{"WithYear out of range", func() { d.WithYear(-5000) }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expecting panic")
+				}
+			}()
+			tt.f()
+		})
+	}
+}
@@ -13,6 +13,8 @@ type LocalDateTime struct {
 // LocalDateTimeOf returns the LocalDateTime that stores the specified year, month, day,
 // hour, minute, second, and nanosecond offset within the specified second.
 // The same range of values as supported by OfLocalDate and OfLocalTime are allowed here.
+// Unlike LocalDateOf and NewLocalDateTime, the date need not exist in the calendar: a day beyond the end of the month
+// overflows into the following month, such that 31st April is interpreted as 1st May.
 func LocalDateTimeOf(year int, month Month, day, hour, min, sec, nsec int) LocalDateTime {
 	date, err := makeDate(year, int(month), day)
 	if err != nil {
@@ -27,6 +29,23 @@ func LocalDateTimeOf(year int, month Month, day, hour, min, sec, nsec int) Local
 	return LocalDateTime{v: makeDateTime(date, time)}
 }
 
+// NewLocalDateTime returns the LocalDateTime that stores the specified year, month, day,
+// hour, minute, second, and nanosecond offset within the specified second, in the same manner as LocalDateTimeOf.
+// Unlike LocalDateTimeOf, the date must exist in the calendar. Instead of panicking, it returns an error matching
+// ErrInvalidDate if the date does not exist, or ErrOutOfRange if the date or time cannot be represented.
+func NewLocalDateTime(year int, month Month, day, hour, min, sec, nsec int) (LocalDateTime, error) {
+	date, err := makeValidDate(year, int(month), day)
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+
+	time, err := makeTime(hour, min, sec, nsec)
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+	return LocalDateTime{v: makeDateTime(date, time)}, nil
+}
+
 // OfLocalDateTime combines the supplied LocalDate and LocalTime into a single LocalDateTime.
 func OfLocalDateTime(date LocalDate, time LocalTime) LocalDateTime {
 	return LocalDateTime{v: makeDateTime(int64(date), time.v)}
@@ -93,6 +112,95 @@ func (d LocalDateTime) UTC() OffsetDateTime {
 	return OffsetDateTime{v: d.v}
 }
 
+// Year returns the year specified by d.
+func (d LocalDateTime) Year() int {
+	date, _ := d.Split()
+	return date.Year()
+}
+
+// Month returns the month of the year specified by d.
+func (d LocalDateTime) Month() Month {
+	date, _ := d.Split()
+	return date.Month()
+}
+
+// Day returns the day of the month specified by d.
+func (d LocalDateTime) Day() int {
+	date, _ := d.Split()
+	return date.Day()
+}
+
+// Weekday returns the day of the week specified by d.
+func (d LocalDateTime) Weekday() Weekday {
+	date, _ := d.Split()
+	return date.Weekday()
+}
+
+// Hour returns the hour specified by d, in the range [0, 23].
+func (d LocalDateTime) Hour() int {
+	_, time := splitDateAndTime(d.v)
+	hour, _, _, _ := fromTime(time)
+	return hour
+}
+
+// Minute returns the minute offset within the hour specified by d, in the range [0, 59].
+func (d LocalDateTime) Minute() int {
+	_, time := splitDateAndTime(d.v)
+	_, min, _, _ := fromTime(time)
+	return min
+}
+
+// Second returns the second offset within the minute specified by d, in the range [0, 59].
+func (d LocalDateTime) Second() int {
+	_, time := splitDateAndTime(d.v)
+	_, _, sec, _ := fromTime(time)
+	return sec
+}
+
+// Nanosecond returns the nanosecond offset within the second specified by d, in the range [0, 999999999].
+func (d LocalDateTime) Nanosecond() int {
+	_, time := splitDateAndTime(d.v)
+	return timeNanoseconds(time)
+}
+
+// WithYear returns d with its year replaced, and the day clamped as described for LocalDate.WithYear.
+// This function panics if the resulting date cannot be represented.
+func (d LocalDateTime) WithYear(year int) LocalDateTime {
+	return LocalDateTime{v: withDateOfBigDate(d.v, func(date int64) (int64, error) { return withYearMonth(date, year, int(LocalDate(date).Month())) })}
+}
+
+// WithMonth returns d with its month replaced, and the day clamped as described for LocalDate.WithMonth.
+// This function panics if month is not valid, or if the resulting date cannot be represented.
+func (d LocalDateTime) WithMonth(month Month) LocalDateTime {
+	return LocalDateTime{v: withDateOfBigDate(d.v, func(date int64) (int64, error) { return withYearMonth(date, LocalDate(date).Year(), int(month)) })}
+}
+
+// WithDay returns d with its day of the month replaced. This function panics if the day does not exist in the month of d.
+func (d LocalDateTime) WithDay(day int) LocalDateTime {
+	return LocalDateTime{v: withDateOfBigDate(d.v, func(date int64) (int64, error) { return withDay(date, day) })}
+}
+
+// WithHour returns d with its hour replaced. This function panics if hour is not in the range [0, 23].
+func (d LocalDateTime) WithHour(hour int) LocalDateTime {
+	return LocalDateTime{v: withTimeOfBigDate(d.v, func(_, min, sec, nsec int) (int, int, int, int) { return hour, min, sec, nsec })}
+}
+
+// WithMinute returns d with its minute replaced. This function panics if min is not in the range [0, 59].
+func (d LocalDateTime) WithMinute(min int) LocalDateTime {
+	return LocalDateTime{v: withTimeOfBigDate(d.v, func(hour, _, sec, nsec int) (int, int, int, int) { return hour, min, sec, nsec })}
+}
+
+// WithSecond returns d with its second replaced. This function panics if sec is not in the range [0, 59].
+func (d LocalDateTime) WithSecond(sec int) LocalDateTime {
+	return LocalDateTime{v: withTimeOfBigDate(d.v, func(hour, min, _, nsec int) (int, int, int, int) { return hour, min, sec, nsec })}
+}
+
+// WithNanosecond returns d with its nanosecond offset within the second replaced.
+// This function panics if nsec is not in the range [0, 999999999].
+func (d LocalDateTime) WithNanosecond(nsec int) LocalDateTime {
+	return LocalDateTime{v: withTimeOfBigDate(d.v, func(hour, min, sec, _ int) (int, int, int, int) { return hour, min, sec, nsec })}
+}
+
 // Add returns the datetime d+v.
 // This function panics if the resulting datetime would fall outside of the allowed range.
 func (d LocalDateTime) Add(v Duration) LocalDateTime {
@@ -109,6 +217,16 @@ func (d LocalDateTime) CanAdd(v Duration) bool {
 	return err == nil
 }
 
+// AddChecked returns the datetime d+v, in the same manner as Add.
+// Instead of panicking, it returns an error matching ErrOutOfRange if the result cannot be represented.
+func (d LocalDateTime) AddChecked(v Duration) (LocalDateTime, error) {
+	out, err := addDurationToBigDate(d.v, v)
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+	return LocalDateTime{v: out}, nil
+}
+
 // AddDate returns the datetime corresponding to adding the given number of years, months, and days to d.
 // This function panic if the resulting datetime would fall outside of the allowed date range.
 func (d LocalDateTime) AddDate(years, months, days int) LocalDateTime {
@@ -125,6 +243,91 @@ func (d LocalDateTime) CanAddDate(years, months, days int) bool {
 	return err == nil
 }
 
+// AddDateChecked returns the datetime corresponding to adding the given number of years, months, and days to d.
+// Instead of panicking, it returns an error matching ErrOutOfRange if the result cannot be represented.
+func (d LocalDateTime) AddDateChecked(years, months, days int) (LocalDateTime, error) {
+	out, err := addDateToBigDate(d.v, years, months, days)
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+	return LocalDateTime{v: out}, nil
+}
+
+// With returns the datetime with the date returned by applying adj to the date of d, and the same time of day.
+func (d LocalDateTime) With(adj Adjuster) LocalDateTime {
+	date, time := splitDateAndTime(d.v)
+	return LocalDateTime{v: makeDateTime(int64(adj(LocalDate(date))), time)}
+}
+
+// Truncate returns the result of rounding d down to the start of the unit u in which it occurs,
+// such as the start of the hour, the Monday of the ISO week, or the 1st of the month.
+// This function panics if u is not supported.
+func (d LocalDateTime) Truncate(u Unit) LocalDateTime {
+	return LocalDateTime{v: mustRoundBigDate(roundBigDate(d.v, u, roundFloor))}
+}
+
+// Round returns the result of rounding d to the nearest start of the unit u, as described for Truncate.
+// Halfway values are rounded up. This function panics if u is not supported, or if the result is out of range.
+func (d LocalDateTime) Round(u Unit) LocalDateTime {
+	return LocalDateTime{v: mustRoundBigDate(roundBigDate(d.v, u, roundNearest))}
+}
+
+// Ceil returns the result of rounding d up to the start of the unit u, as described for Truncate.
+// This function panics if u is not supported, or if the result is out of range.
+func (d LocalDateTime) Ceil(u Unit) LocalDateTime {
+	return LocalDateTime{v: mustRoundBigDate(roundBigDate(d.v, u, roundCeil))}
+}
+
+// TruncateExtent returns the result of rounding d down to a multiple of step, counted from the supplied anchor.
+// This function panics if step is not positive, or if anchor is not valid.
+func (d LocalDateTime) TruncateExtent(step Extent, anchor Anchor) LocalDateTime {
+	return LocalDateTime{v: d.roundToStep(step, anchor, roundFloor)}
+}
+
+// RoundExtent returns the result of rounding d to the nearest multiple of step, counted from the supplied anchor.
+// Halfway values are rounded up. This function panics if step is not positive, if anchor is not valid,
+// or if the result is out of range.
+func (d LocalDateTime) RoundExtent(step Extent, anchor Anchor) LocalDateTime {
+	return LocalDateTime{v: d.roundToStep(step, anchor, roundNearest)}
+}
+
+// CeilExtent returns the result of rounding d up to a multiple of step, counted from the supplied anchor.
+// This function panics if step is not positive, if anchor is not valid, or if the result is out of range.
+func (d LocalDateTime) CeilExtent(step Extent, anchor Anchor) LocalDateTime {
+	return LocalDateTime{v: d.roundToStep(step, anchor, roundCeil)}
+}
+
+func (d LocalDateTime) roundToStep(step Extent, anchor Anchor, mode roundMode) big.Int {
+	return mustRoundBigDate(roundBigDateToStep(d.v, step, big.Int{}, mustMidnight(anchor), mode))
+}
+
+// Plus returns the datetime corresponding to adding n of the unit u to d.
+// Units of time, including UnitDay, are added as a fixed duration. Units of months or longer are added in the same manner as AddDate.
+// This function panics if u is not supported, or if the resulting datetime cannot be represented.
+func (d LocalDateTime) Plus(n int64, u Unit) LocalDateTime {
+	out, err := plusBigDate(d.v, n, u)
+	if err != nil {
+		panic(err.Error())
+	}
+	return LocalDateTime{v: out}
+}
+
+// Minus returns the datetime corresponding to subtracting n of the unit u from d, in the same manner as Plus.
+func (d LocalDateTime) Minus(n int64, u Unit) LocalDateTime {
+	return d.Plus(-n, u)
+}
+
+// Until returns the number of whole units u from d until d2, which is negative if d2 is before d.
+// Months and larger units are counted in the same manner as BetweenDateTimes.
+// This function panics if u is not supported, or if the result cannot be represented.
+func (d LocalDateTime) Until(d2 LocalDateTime, u Unit) int64 {
+	out, err := untilBigDate(d.v, d2.v, u)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
 // Sub returns the duration d-u.
 func (d LocalDateTime) Sub(u LocalDateTime) Duration {
 	out := new(big.Int).Set(&d.v)
@@ -132,6 +335,40 @@ func (d LocalDateTime) Sub(u LocalDateTime) Duration {
 	return Duration{v: *out}
 }
 
+// BetweenDateTimes returns the period and duration between the datetimes a and b,
+// such that a.AddDate(years, months, days).Add(d) reproduces b, where years, months and days are the components
+// of the period. The period is calculated between the dates of a and b as by BetweenDates, and the remaining
+// duration is always less than a day. Both the period and duration are negative if b is before a.
+func BetweenDateTimes(a, b LocalDateTime) (Period, Duration) {
+	return betweenDateTimes(a.v, b.v)
+}
+
+func betweenDateTimes(a, b big.Int) (Period, Duration) {
+	dateA, timeA := splitDateAndTime(a)
+	dateB, timeB := splitDateAndTime(b)
+
+	// Exclude the final date if its time has not yet been reached, so that the period does not overshoot b.
+	switch cmp := b.Cmp(&a); {
+	case cmp > 0 && timeB < timeA:
+		dateB--
+	case cmp < 0 && timeB > timeA:
+		dateB++
+	}
+
+	years, months, days, err := betweenDates(dateA, dateB)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	v, err := addDateToBigDate(a, years, months, days)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	v.Sub(&b, &v)
+	return Period{Years: years, Months: months, Days: days}, Duration{v: v}
+}
+
 func (d LocalDateTime) String() string {
 	date, time := splitDateAndTime(d.v)
 	hour, min, sec, nsec := fromTime(time)
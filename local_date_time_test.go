@@ -324,3 +324,79 @@ func TestLocalDateTime_UTC(t *testing.T) {
 		t.Errorf("dt.UTC() = %s, want %s", output, expected)
 	}
 }
+
+func TestBetweenDateTimes(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		a, b     chrono.LocalDateTime
+		period   chrono.Period
+		duration chrono.Duration
+	}{
+		{"forwards", chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0), chrono.LocalDateTimeOf(2020, chrono.May, 20, 15, 30, 0, 0), chrono.Period{Months: 2, Days: 2}, chrono.DurationOf(3*chrono.Hour + 30*chrono.Minute)},
+		{"forwards earlier time", chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0), chrono.LocalDateTimeOf(2020, chrono.May, 20, 6, 0, 0, 0), chrono.Period{Months: 2, Days: 1}, chrono.DurationOf(18 * chrono.Hour)},
+		{"backwards", chrono.LocalDateTimeOf(2020, chrono.May, 20, 15, 30, 0, 0), chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0), chrono.Period{Months: -2, Days: -2}, chrono.DurationOf(-3*chrono.Hour - 30*chrono.Minute)},
+		{"backwards later time", chrono.LocalDateTimeOf(2020, chrono.May, 20, 6, 0, 0, 0), chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0), chrono.Period{Months: -2, Days: -1}, chrono.DurationOf(-18 * chrono.Hour)},
+		{"less than a day", chrono.LocalDateTimeOf(2020, chrono.March, 18, 23, 0, 0, 0), chrono.LocalDateTimeOf(2020, chrono.March, 19, 1, 0, 0, 0), chrono.Period{}, chrono.DurationOf(2 * chrono.Hour)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			p, d := chrono.BetweenDateTimes(tt.a, tt.b)
+			if !p.Equal(tt.period) {
+				t.Errorf("period = %s, want %s", p, tt.period)
+			}
+
+			if d.Compare(tt.duration) != 0 {
+				t.Errorf("duration = %s, want %s", d, tt.duration)
+			}
+
+			if out := tt.a.AddDate(int(p.Years), int(p.Months), int(p.Days)).Add(d); out.Compare(tt.b) != 0 {
+				t.Errorf("a.AddDate().Add() = %s, want %s", out, tt.b)
+			}
+		})
+	}
+}
+
+func TestLocalDateTime_fields(t *testing.T) {
+	dt := chrono.LocalDateTimeOf(2020, chrono.January, 31, 13, 45, 30, 500)
+	if dt.Year() != 2020 || dt.Month() != chrono.January || dt.Day() != 31 || dt.Weekday() != chrono.Friday ||
+		dt.Hour() != 13 || dt.Minute() != 45 || dt.Second() != 30 || dt.Nanosecond() != 500 {
+		t.Errorf("incorrect fields of %s", dt)
+	}
+
+	for _, tt := range []struct {
+		name     string
+		out      chrono.LocalDateTime
+		expected chrono.LocalDateTime
+	}{
+		{"WithYear", dt.WithYear(2021), chrono.LocalDateTimeOf(2021, chrono.January, 31, 13, 45, 30, 500)},
+		{"WithMonth clamped", dt.WithMonth(chrono.April), chrono.LocalDateTimeOf(2020, chrono.April, 30, 13, 45, 30, 500)},
+		{"WithDay", dt.WithDay(2), chrono.LocalDateTimeOf(2020, chrono.January, 2, 13, 45, 30, 500)},
+		{"WithHour", dt.WithHour(0), chrono.LocalDateTimeOf(2020, chrono.January, 31, 0, 45, 30, 500)},
+		{"WithMinute", dt.WithMinute(59), chrono.LocalDateTimeOf(2020, chrono.January, 31, 13, 59, 30, 500)},
+		{"WithSecond", dt.WithSecond(0), chrono.LocalDateTimeOf(2020, chrono.January, 31, 13, 45, 0, 500)},
+		{"WithNanosecond", dt.WithNanosecond(999999999), chrono.LocalDateTimeOf(2020, chrono.January, 31, 13, 45, 30, 999999999)},
+		{"before epoch", chrono.LocalDateTimeOf(1969, chrono.December, 31, 23, 0, 0, 0).WithMinute(30), chrono.LocalDateTimeOf(1969, chrono.December, 31, 23, 30, 0, 0)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.out.Compare(tt.expected) != 0 {
+				t.Errorf("out = %s, want %s", tt.out, tt.expected)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name string
+		f    func()
+	}{
+		{"WithDay invalid", func() { dt.WithMonth(chrono.February).WithDay(30) }},
+		{"WithHour business hour", func() { dt.WithHour(24) }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expecting panic")
+				}
+			}()
+			tt.f()
+		})
+	}
+}
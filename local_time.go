@@ -20,6 +20,17 @@ func LocalTimeOf(hour, min, sec, nsec int) LocalTime {
 	return LocalTime{v: out}
 }
 
+// NewLocalTime returns a LocalTime that represents the specified hour, minute, second, and nanosecond offset
+// within the specified second, in the same manner as LocalTimeOf.
+// Instead of panicking, it returns an error matching ErrOutOfRange if an invalid time is specified.
+func NewLocalTime(hour, min, sec, nsec int) (LocalTime, error) {
+	out, err := makeTime(hour, min, sec, nsec)
+	if err != nil {
+		return LocalTime{}, err
+	}
+	return LocalTime{v: out}, nil
+}
+
 // BusinessHour returns the hour specified by t.
 // If the hour is greater than 23, that hour is returned without normalization.
 func (t LocalTime) BusinessHour() int {
@@ -39,6 +50,46 @@ func (t LocalTime) Nanosecond() int {
 	return timeNanoseconds(t.v)
 }
 
+// Hour returns the hour specified by t, normalized to the 24-hour clock as described for Clock.
+func (t LocalTime) Hour() int {
+	hour, _, _, _ := fromTime(t.v)
+	return hour
+}
+
+// Minute returns the minute offset within the hour specified by t, in the range [0, 59].
+func (t LocalTime) Minute() int {
+	_, min, _, _ := fromTime(t.v)
+	return min
+}
+
+// Second returns the second offset within the minute specified by t, in the range [0, 59].
+func (t LocalTime) Second() int {
+	_, _, sec, _ := fromTime(t.v)
+	return sec
+}
+
+// WithHour returns t with its hour replaced, which may be a business hour up to 99.
+// This function panics if hour is not in the range [0, 99].
+func (t LocalTime) WithHour(hour int) LocalTime {
+	return LocalTime{v: withTimeFields(t.v, func(_, min, sec, nsec int) (int, int, int, int) { return hour, min, sec, nsec })}
+}
+
+// WithMinute returns t with its minute replaced. This function panics if min is not in the range [0, 59].
+func (t LocalTime) WithMinute(min int) LocalTime {
+	return LocalTime{v: withTimeFields(t.v, func(hour, _, sec, nsec int) (int, int, int, int) { return hour, min, sec, nsec })}
+}
+
+// WithSecond returns t with its second replaced. This function panics if sec is not in the range [0, 59].
+func (t LocalTime) WithSecond(sec int) LocalTime {
+	return LocalTime{v: withTimeFields(t.v, func(hour, min, _, nsec int) (int, int, int, int) { return hour, min, sec, nsec })}
+}
+
+// WithNanosecond returns t with its nanosecond offset within the second replaced.
+// This function panics if nsec is not in the range [0, 999999999].
+func (t LocalTime) WithNanosecond(nsec int) LocalTime {
+	return LocalTime{v: withTimeFields(t.v, func(hour, min, sec, _ int) (int, int, int, int) { return hour, min, sec, nsec })}
+}
+
 // Sub returns the duration t-u.
 func (t LocalTime) Sub(u LocalTime) Extent {
 	return Extent(t.v - u.v)
@@ -61,6 +112,83 @@ func (t LocalTime) CanAdd(v Extent) bool {
 	return err == nil
 }
 
+// AddChecked returns the time t+v, in the same manner as Add.
+// Instead of panicking, it returns an error matching ErrOutOfRange if the result exceeds the maximum representable time.
+func (t LocalTime) AddChecked(v Extent) (LocalTime, error) {
+	out, err := addTime(t.v, int64(v))
+	if err != nil {
+		return LocalTime{}, err
+	}
+	return LocalTime{v: out}, nil
+}
+
+// Truncate returns the result of rounding t down to a multiple of the unit u, which must be a unit of time or UnitDay.
+// This function panics if u is not supported.
+func (t LocalTime) Truncate(u Unit) LocalTime {
+	return LocalTime{v: mustRoundTime(roundTime(t.v, u, roundFloor))}
+}
+
+// Round returns the result of rounding t to the nearest multiple of the unit u, which must be a unit of time or UnitDay.
+// Halfway values are rounded up. This function panics if u is not supported, or if the result is out of range.
+func (t LocalTime) Round(u Unit) LocalTime {
+	return LocalTime{v: mustRoundTime(roundTime(t.v, u, roundNearest))}
+}
+
+// Ceil returns the result of rounding t up to a multiple of the unit u, which must be a unit of time or UnitDay.
+// This function panics if u is not supported, or if the result is out of range.
+func (t LocalTime) Ceil(u Unit) LocalTime {
+	return LocalTime{v: mustRoundTime(roundTime(t.v, u, roundCeil))}
+}
+
+// TruncateExtent returns the result of rounding t down to a multiple of step since midnight.
+// This function panics if step is not positive.
+func (t LocalTime) TruncateExtent(step Extent) LocalTime {
+	return LocalTime{v: mustRoundTime(roundTimeToStep(t.v, step, roundFloor))}
+}
+
+// RoundExtent returns the result of rounding t to the nearest multiple of step since midnight.
+// Halfway values are rounded up. This function panics if step is not positive, or if the result is out of range.
+func (t LocalTime) RoundExtent(step Extent) LocalTime {
+	return LocalTime{v: mustRoundTime(roundTimeToStep(t.v, step, roundNearest))}
+}
+
+// CeilExtent returns the result of rounding t up to a multiple of step since midnight.
+// This function panics if step is not positive, or if the result is out of range.
+func (t LocalTime) CeilExtent(step Extent) LocalTime {
+	return LocalTime{v: mustRoundTime(roundTimeToStep(t.v, step, roundCeil))}
+}
+
+// Plus returns the time corresponding to adding n of the unit u to t, in the same manner as Add.
+// u must be a unit of time shorter than a day. This function panics if u is not supported,
+// or if the result exceeds the maximum representable time.
+func (t LocalTime) Plus(n int64, u Unit) LocalTime {
+	e, ok := u.extent()
+	if !ok || u == UnitDay {
+		panic(errUnsupportedUnit(u).Error())
+	}
+
+	v, err := e.MulChecked(n)
+	if err != nil {
+		panic(err.Error())
+	}
+	return t.Add(v)
+}
+
+// Minus returns the time corresponding to subtracting n of the unit u from t, in the same manner as Plus.
+func (t LocalTime) Minus(n int64, u Unit) LocalTime {
+	return t.Plus(-n, u)
+}
+
+// Until returns the number of whole units u from t until t2, which is negative if t2 is before t.
+// As with Plus, u must be a unit of time shorter than a day. This function panics if u is not supported.
+func (t LocalTime) Until(t2 LocalTime, u Unit) int64 {
+	e, ok := u.extent()
+	if !ok || u == UnitDay {
+		panic(errUnsupportedUnit(u).Error())
+	}
+	return (t2.v - t.v) / int64(e)
+}
+
 // Compare compares t with t2. If t is before t2, it returns -1;
 // if t is after t2, it returns 1; if they're the same, it returns 0.
 func (t LocalTime) Compare(t2 LocalTime) int {
@@ -162,3 +162,36 @@ func TestLocalTime_UTC(t *testing.T) {
 		t.Errorf("time.UTC() = %s, want %s", output, expected)
 	}
 }
+
+func TestLocalTime_fields(t *testing.T) {
+	tm := chrono.LocalTimeOf(25, 30, 59, 12345678)
+	if tm.Hour() != 1 || tm.Minute() != 30 || tm.Second() != 59 || tm.Nanosecond() != 12345678 {
+		t.Errorf("fields = %d, %d, %d, %d", tm.Hour(), tm.Minute(), tm.Second(), tm.Nanosecond())
+	}
+
+	for _, tt := range []struct {
+		name     string
+		out      chrono.LocalTime
+		expected chrono.LocalTime
+	}{
+		{"WithHour", tm.WithHour(3), chrono.LocalTimeOf(3, 30, 59, 12345678)},
+		{"WithMinute", tm.WithMinute(0), chrono.LocalTimeOf(25, 0, 59, 12345678)},
+		{"WithSecond", tm.WithSecond(1), chrono.LocalTimeOf(25, 30, 1, 12345678)},
+		{"WithNanosecond", tm.WithNanosecond(0), chrono.LocalTimeOf(25, 30, 59, 0)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.out.Compare(tt.expected) != 0 {
+				t.Errorf("out = %s, want %s", tt.out, tt.expected)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expecting panic")
+			}
+		}()
+		tm.WithMinute(60)
+	})
+}
@@ -0,0 +1,126 @@
+package chrono
+
+import "fmt"
+
+// MonthDay is a day of a month, such as 2nd January, without a year, time or time zone.
+// It is useful for values that recur every year, such as birthdays and anniversaries.
+//
+// Any two MonthDays can be compared to each other using the standard comparison operators,
+// although, unlike LocalDate, the difference between them is not meaningful.
+// The default value, 0, represents 1st January. 29th February is a valid MonthDay, although it only occurs in leap years.
+type MonthDay int16
+
+// MonthDayOf returns the MonthDay that represents the specified month and day.
+// This function panics if the day does not exist in the month in any year.
+func MonthDayOf(month Month, day int) MonthDay {
+	out, err := makeMonthDay(int(month), day)
+	if err != nil {
+		panic(err.Error())
+	}
+	return MonthDay(out)
+}
+
+// NewMonthDay returns the MonthDay that represents the specified month and day, in the same manner as MonthDayOf.
+// Instead of panicking, it returns an error matching ErrInvalidDate if the day does not exist in the month in any year.
+func NewMonthDay(month Month, day int) (MonthDay, error) {
+	out, err := makeMonthDay(int(month), day)
+	if err != nil {
+		return 0, err
+	}
+	return MonthDay(out), nil
+}
+
+// Date returns the month and day represented by md.
+func (md MonthDay) Date() (month Month, day int) {
+	_month, day := fromMonthDay(int64(md))
+	return Month(_month), day
+}
+
+// Month returns the month specified by md.
+func (md MonthDay) Month() Month {
+	month, _ := md.Date()
+	return month
+}
+
+// Day returns the day of the month specified by md.
+func (md MonthDay) Day() int {
+	_, day := md.Date()
+	return day
+}
+
+// IsValidYear reports whether md exists in the specified year, which is false only for 29th February in a non-leap year.
+func (md MonthDay) IsValidYear(year int) bool {
+	month, day := md.Date()
+	return isDateValid(year, int(month), day)
+}
+
+// AtYear returns the LocalDate that represents md in the specified year.
+// If md is 29th February and the year is not a leap year, the date is clamped to 28th February.
+// This function panics if the date cannot be represented.
+func (md MonthDay) AtYear(year int) LocalDate {
+	month, day := md.Date()
+	if n := getDaysInMonth(year, int(month)); day > n {
+		day = n
+	}
+	return LocalDate(mustDate(makeDate(year, int(month), day)))
+}
+
+func (md MonthDay) String() string {
+	month, day := md.Date()
+	return fmt.Sprintf("--%02d-%02d", month, day)
+}
+
+// Format returns a textual representation of md formatted according to the layout defined by the argument,
+// such as ISO8601MonthDay. See the constants section of the documentation to see how to represent the layout format.
+// Year specifiers are formatted as if for the leap year 2000. Time format specifiers encountered in the layout results in a panic.
+func (md MonthDay) Format(layout string) string {
+	date := int32(monthDayToDate(int64(md)))
+	out, err := formatDateTimeOffset(layout, &date, nil, nil)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// Parse a formatted string and store the value it represents in md.
+// See the constants section of the documentation to see how to represent the layout format.
+// Any year parsed from the value is discarded, after it has been used to check the validity of the date.
+// Time format specifiers encountered in the layout results in a panic.
+func (md *MonthDay) Parse(layout, value string) error {
+	v := monthDayToDate(int64(*md))
+	if err := parseDateAndTime(layout, value, &v, nil, nil); err != nil {
+		return err
+	}
+
+	_, month, day, err := fromDate(v)
+	if err != nil {
+		return err
+	}
+
+	out, err := makeMonthDay(month, day)
+	if err != nil {
+		return err
+	}
+
+	*md = MonthDay(out)
+	return nil
+}
+
+// monthDayLeapYear is a leap year in which every MonthDay exists, used for formatting and parsing.
+const monthDayLeapYear = 2000
+
+func makeMonthDay(month, day int) (int64, error) {
+	if !isDateValid(monthDayLeapYear, month, day) {
+		return 0, errInvalidDate("invalid month-day")
+	}
+	return int64(month-1)<<5 | int64(day-1), nil
+}
+
+func fromMonthDay(v int64) (month, day int) {
+	return int(v>>5) + 1, int(v&31) + 1
+}
+
+func monthDayToDate(v int64) int64 {
+	month, day := fromMonthDay(v)
+	return makeJDN(monthDayLeapYear, int64(month), int64(day))
+}
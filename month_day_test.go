@@ -0,0 +1,168 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestMonthDay(t *testing.T) {
+	for _, tt := range []struct {
+		month chrono.Month
+		day   int
+		str   string
+	}{
+		{chrono.January, 1, "--01-01"},
+		{chrono.February, 29, "--02-29"},
+		{chrono.December, 31, "--12-31"},
+	} {
+		t.Run(tt.str, func(t *testing.T) {
+			md := chrono.MonthDayOf(tt.month, tt.day)
+
+			if month, day := md.Date(); month != tt.month || day != tt.day {
+				t.Errorf("md.Date() = %s, %d, want %s, %d", month, day, tt.month, tt.day)
+			}
+
+			if month := md.Month(); month != tt.month {
+				t.Errorf("md.Month() = %s, want %s", month, tt.month)
+			}
+
+			if day := md.Day(); day != tt.day {
+				t.Errorf("md.Day() = %d, want %d", day, tt.day)
+			}
+
+			if str := md.String(); str != tt.str {
+				t.Errorf("md.String() = %s, want %s", str, tt.str)
+			}
+		})
+	}
+
+	t.Run("zero value", func(t *testing.T) {
+		var md chrono.MonthDay
+		if expected := chrono.MonthDayOf(chrono.January, 1); md != expected {
+			t.Errorf("zero value = %s, want %s", md, expected)
+		}
+	})
+
+	t.Run("ordering", func(t *testing.T) {
+		dates := []chrono.MonthDay{
+			chrono.MonthDayOf(chrono.January, 31),
+			chrono.MonthDayOf(chrono.February, 1),
+			chrono.MonthDayOf(chrono.February, 29),
+			chrono.MonthDayOf(chrono.March, 1),
+			chrono.MonthDayOf(chrono.December, 31),
+		}
+
+		for i := 1; i < len(dates); i++ {
+			if !(dates[i-1] < dates[i]) {
+				t.Errorf("expecting %s to be before %s", dates[i-1], dates[i])
+			}
+		}
+	})
+}
+
+func TestNewMonthDay(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		month chrono.Month
+		day   int
+		err   error
+	}{
+		{"valid", chrono.March, 31, nil},
+		{"leap day", chrono.February, 29, nil},
+		{"invalid day", chrono.February, 30, chrono.ErrInvalidDate},
+		{"zero day", chrono.March, 0, chrono.ErrInvalidDate},
+		{"invalid month", 13, 1, chrono.ErrInvalidDate},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := chrono.NewMonthDay(tt.month, tt.day)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("NewMonthDay() = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestMonthDay_AtYear(t *testing.T) {
+	for _, tt := range []struct {
+		md          chrono.MonthDay
+		year        int
+		isValidYear bool
+		expected    chrono.LocalDate
+	}{
+		{chrono.MonthDayOf(chrono.February, 29), 2020, true, chrono.LocalDateOf(2020, chrono.February, 29)},
+		{chrono.MonthDayOf(chrono.February, 29), 2021, false, chrono.LocalDateOf(2021, chrono.February, 28)},
+		{chrono.MonthDayOf(chrono.February, 29), 1900, false, chrono.LocalDateOf(1900, chrono.February, 28)},
+		{chrono.MonthDayOf(chrono.February, 28), 2021, true, chrono.LocalDateOf(2021, chrono.February, 28)},
+		{chrono.MonthDayOf(chrono.December, 31), 2021, true, chrono.LocalDateOf(2021, chrono.December, 31)},
+	} {
+		t.Run(tt.expected.String(), func(t *testing.T) {
+			if isValidYear := tt.md.IsValidYear(tt.year); isValidYear != tt.isValidYear {
+				t.Errorf("md.IsValidYear(%d) = %t, want %t", tt.year, isValidYear, tt.isValidYear)
+			}
+
+			if d := tt.md.AtYear(tt.year); d != tt.expected {
+				t.Errorf("md.AtYear(%d) = %s, want %s", tt.year, d, tt.expected)
+			}
+		})
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expecting panic for unrepresentable date")
+			}
+		}()
+		chrono.MonthDayOf(chrono.January, 1).AtYear(-4713)
+	}()
+}
+
+func TestMonthDay_Format(t *testing.T) {
+	md := chrono.MonthDayOf(chrono.February, 29)
+	if out := md.Format(chrono.ISO8601MonthDay); out != "--02-29" {
+		t.Errorf("md.Format() = %s, want --02-29", out)
+	}
+
+	if out := md.Format("%d %B"); out != "29 February" {
+		t.Errorf("md.Format() = %s, want 29 February", out)
+	}
+}
+
+func TestMonthDay_Parse(t *testing.T) {
+	for _, tt := range []struct {
+		layout   string
+		value    string
+		expected chrono.MonthDay
+	}{
+		{chrono.ISO8601MonthDay, "--02-29", chrono.MonthDayOf(chrono.February, 29)},
+		{"%d/%m", "31/12", chrono.MonthDayOf(chrono.December, 31)},
+		{chrono.ISO8601DateExtended, "2021-07-04", chrono.MonthDayOf(chrono.July, 4)},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			var md chrono.MonthDay
+			if err := md.Parse(tt.layout, tt.value); err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+
+			if md != tt.expected {
+				t.Errorf("md.Parse() = %s, want %s", md, tt.expected)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		layout string
+		value  string
+	}{
+		{chrono.ISO8601MonthDay, "--02-30"},
+		{chrono.ISO8601DateExtended, "2021-02-29"},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			var md chrono.MonthDay
+			if err := md.Parse(tt.layout, tt.value); err == nil {
+				t.Error("expecting error")
+			}
+		})
+	}
+}
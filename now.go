@@ -0,0 +1,54 @@
+package chrono
+
+import "time"
+
+// The functions in this file read the current date and time from the system's wall clock, using the same runtime clock
+// as the standard library's time.Now. The resolution of the reading is one nanosecond,
+// although the actual precision depends on the operating system.
+// Since the wall clock can be adjusted (for example, when it is synchronized), these readings are not monotonic,
+// and Now should instead be used to measure elapsed time.
+
+// NowOffsetDateTime returns the current date and time at the specified offset.
+func NowOffsetDateTime(offset Offset) OffsetDateTime {
+	secs, nsec, _ := wallNow()
+	return nowAt(secs, nsec, int64(offset))
+}
+
+// NowUTC returns the current date and time in UTC.
+func NowUTC() OffsetDateTime {
+	return NowOffsetDateTime(UTC)
+}
+
+// NowLocal returns the current date and time at the offset that is currently in use by the system's local time zone.
+func NowLocal() OffsetDateTime {
+	secs, nsec, offset := wallNow()
+	return nowAt(secs, nsec, offset)
+}
+
+// NowLocalDateTime returns the current date and time in the system's local time zone.
+func NowLocalDateTime() LocalDateTime {
+	return NowLocal().Local()
+}
+
+// NowOffsetTime returns the current time of day at the specified offset.
+func NowOffsetTime(offset Offset) OffsetTime {
+	_, t := NowOffsetDateTime(offset).Split()
+	return t
+}
+
+// Today returns the current date at the specified offset.
+func Today(offset Offset) LocalDate {
+	d, _ := NowOffsetDateTime(offset).Split()
+	return d
+}
+
+// wallNow returns the current Unix time in seconds and nanoseconds, and the current offset of the system's local time zone.
+func wallNow() (secs, nsec, offset int64) {
+	_secs, _nsec, _ := walltime()
+	_, o := time.Unix(_secs, int64(_nsec)).Zone()
+	return _secs, int64(_nsec), int64(o) * oneSecond
+}
+
+func nowAt(secs, nsec, offset int64) OffsetDateTime {
+	return OffsetDateTime{v: bigDateToOffset(unixToDateTime(secs, nsec), 0, offset), o: offset}
+}
@@ -0,0 +1,66 @@
+package chrono_test
+
+import (
+	"testing"
+	gotime "time"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestNow_wallClock(t *testing.T) {
+	checkNear := func(t *testing.T, name string, got chrono.OffsetDateTime, before, after gotime.Time) {
+		t.Helper()
+
+		lower := chrono.Unix(before.Unix(), int64(before.Nanosecond())).UTC()
+		upper := chrono.Unix(after.Unix(), int64(after.Nanosecond())).UTC()
+		if got.UTC().Compare(lower) < 0 || got.UTC().Compare(upper) > 0 {
+			t.Errorf("%s = %s, want between %s and %s", name, got, lower, upper)
+		}
+	}
+
+	t.Run("NowUTC", func(t *testing.T) {
+		before := gotime.Now()
+		now := chrono.NowUTC()
+		after := gotime.Now()
+
+		checkNear(t, "NowUTC()", now, before, after)
+		if now.Offset() != chrono.UTC {
+			t.Errorf("NowUTC().Offset() = %s, want UTC", now.Offset())
+		}
+	})
+
+	t.Run("NowOffsetDateTime", func(t *testing.T) {
+		offset := chrono.OffsetOf(-5, 30)
+
+		before := gotime.Now()
+		now := chrono.NowOffsetDateTime(offset)
+		after := gotime.Now()
+
+		checkNear(t, "NowOffsetDateTime()", now, before, after)
+		if now.Offset() != offset {
+			t.Errorf("NowOffsetDateTime().Offset() = %s, want %s", now.Offset(), offset)
+		}
+	})
+
+	t.Run("NowLocal", func(t *testing.T) {
+		before := gotime.Now()
+		now := chrono.NowLocal()
+		after := gotime.Now()
+
+		checkNear(t, "NowLocal()", now, before, after)
+		if _, offset := after.Zone(); now.Offset() != chrono.Offset(offset)*chrono.Offset(chrono.Second) {
+			t.Errorf("NowLocal().Offset() = %s, want %d seconds", now.Offset(), offset)
+		}
+	})
+
+	t.Run("Today", func(t *testing.T) {
+		before := gotime.Now().UTC()
+		today := chrono.Today(chrono.UTC)
+		after := gotime.Now().UTC()
+
+		if today != chrono.LocalDateOf(before.Year(), chrono.Month(before.Month()), before.Day()) &&
+			today != chrono.LocalDateOf(after.Year(), chrono.Month(after.Month()), after.Day()) {
+			t.Errorf("Today() = %s, want %s", today, before.Format("2006-01-02"))
+		}
+	})
+}
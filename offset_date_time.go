@@ -14,6 +14,8 @@ type OffsetDateTime struct {
 // hour, minute, second, and nanosecond offset within the specified second.
 // The supplied offset is applied to the returned OffsetDateTime in the same manner as OffsetOf.
 // The same range of values as supported by OfLocalDate and OfLocalTime are allowed here.
+// Unlike LocalDateOf and NewOffsetDateTime, the date need not exist in the calendar: a day beyond the end of the month
+// overflows into the following month, such that 31st April is interpreted as 1st May.
 func OffsetDateTimeOf(year int, month Month, day, hour, min, sec, nsec, offsetHours, offsetMins int) OffsetDateTime {
 	date, err := makeDate(year, int(month), day)
 	if err != nil {
@@ -31,6 +33,27 @@ func OffsetDateTimeOf(year int, month Month, day, hour, min, sec, nsec, offsetHo
 	}
 }
 
+// NewOffsetDateTime returns an OffsetDateTime that represents the specified year, month, day,
+// hour, minute, second, and nanosecond offset within the specified second, in the same manner as OffsetDateTimeOf.
+// Unlike OffsetDateTimeOf, the date must exist in the calendar. Instead of panicking, it returns an error matching
+// ErrInvalidDate if the date does not exist, or ErrOutOfRange if the date or time cannot be represented.
+func NewOffsetDateTime(year int, month Month, day, hour, min, sec, nsec, offsetHours, offsetMins int) (OffsetDateTime, error) {
+	date, err := makeValidDate(year, int(month), day)
+	if err != nil {
+		return OffsetDateTime{}, err
+	}
+
+	time, err := makeTime(hour, min, sec, nsec)
+	if err != nil {
+		return OffsetDateTime{}, err
+	}
+
+	return OffsetDateTime{
+		v: makeDateTime(date, time),
+		o: makeOffset(offsetHours, offsetMins),
+	}, nil
+}
+
 // OfLocalDateOffsetTime combines a LocalDate and OffsetTime into an OffsetDateTime.
 func OfLocalDateOffsetTime(date LocalDate, time OffsetTime) OffsetDateTime {
 	return OffsetDateTime{
@@ -82,6 +105,106 @@ func (d OffsetDateTime) Local() LocalDateTime {
 	return LocalDateTime{d.v}
 }
 
+// Year returns the year specified by d.
+func (d OffsetDateTime) Year() int {
+	date, _ := d.Split()
+	return date.Year()
+}
+
+// Month returns the month of the year specified by d.
+func (d OffsetDateTime) Month() Month {
+	date, _ := d.Split()
+	return date.Month()
+}
+
+// Day returns the day of the month specified by d.
+func (d OffsetDateTime) Day() int {
+	date, _ := d.Split()
+	return date.Day()
+}
+
+// Weekday returns the day of the week specified by d.
+func (d OffsetDateTime) Weekday() Weekday {
+	date, _ := d.Split()
+	return date.Weekday()
+}
+
+// Hour returns the hour specified by d, in the range [0, 23].
+func (d OffsetDateTime) Hour() int {
+	_, time := splitDateAndTime(d.v)
+	hour, _, _, _ := fromTime(time)
+	return hour
+}
+
+// Minute returns the minute offset within the hour specified by d, in the range [0, 59].
+func (d OffsetDateTime) Minute() int {
+	_, time := splitDateAndTime(d.v)
+	_, min, _, _ := fromTime(time)
+	return min
+}
+
+// Second returns the second offset within the minute specified by d, in the range [0, 59].
+func (d OffsetDateTime) Second() int {
+	_, time := splitDateAndTime(d.v)
+	_, _, sec, _ := fromTime(time)
+	return sec
+}
+
+// Nanosecond returns the nanosecond offset within the second specified by d, in the range [0, 999999999].
+func (d OffsetDateTime) Nanosecond() int {
+	_, time := splitDateAndTime(d.v)
+	return timeNanoseconds(time)
+}
+
+// WithYear returns d with its year replaced, and the day clamped as described for LocalDate.WithYear.
+// This function panics if the resulting date cannot be represented.
+func (d OffsetDateTime) WithYear(year int) OffsetDateTime {
+	return OffsetDateTime{v: withDateOfBigDate(d.v, func(date int64) (int64, error) { return withYearMonth(date, year, int(LocalDate(date).Month())) }), o: d.o}
+}
+
+// WithMonth returns d with its month replaced, and the day clamped as described for LocalDate.WithMonth.
+// This function panics if month is not valid, or if the resulting date cannot be represented.
+func (d OffsetDateTime) WithMonth(month Month) OffsetDateTime {
+	return OffsetDateTime{v: withDateOfBigDate(d.v, func(date int64) (int64, error) { return withYearMonth(date, LocalDate(date).Year(), int(month)) }), o: d.o}
+}
+
+// WithDay returns d with its day of the month replaced. This function panics if the day does not exist in the month of d.
+func (d OffsetDateTime) WithDay(day int) OffsetDateTime {
+	return OffsetDateTime{v: withDateOfBigDate(d.v, func(date int64) (int64, error) { return withDay(date, day) }), o: d.o}
+}
+
+// WithHour returns d with its hour replaced. This function panics if hour is not in the range [0, 23].
+func (d OffsetDateTime) WithHour(hour int) OffsetDateTime {
+	return OffsetDateTime{v: withTimeOfBigDate(d.v, func(_, min, sec, nsec int) (int, int, int, int) { return hour, min, sec, nsec }), o: d.o}
+}
+
+// WithMinute returns d with its minute replaced. This function panics if min is not in the range [0, 59].
+func (d OffsetDateTime) WithMinute(min int) OffsetDateTime {
+	return OffsetDateTime{v: withTimeOfBigDate(d.v, func(hour, _, sec, nsec int) (int, int, int, int) { return hour, min, sec, nsec }), o: d.o}
+}
+
+// WithSecond returns d with its second replaced. This function panics if sec is not in the range [0, 59].
+func (d OffsetDateTime) WithSecond(sec int) OffsetDateTime {
+	return OffsetDateTime{v: withTimeOfBigDate(d.v, func(hour, min, _, nsec int) (int, int, int, int) { return hour, min, sec, nsec }), o: d.o}
+}
+
+// WithNanosecond returns d with its nanosecond offset within the second replaced.
+// This function panics if nsec is not in the range [0, 999999999].
+func (d OffsetDateTime) WithNanosecond(nsec int) OffsetDateTime {
+	return OffsetDateTime{v: withTimeOfBigDate(d.v, func(hour, min, sec, _ int) (int, int, int, int) { return hour, min, sec, nsec }), o: d.o}
+}
+
+// WithOffset returns d with its offset replaced, and the same local date and time.
+// The result therefore represents a different instant, unless the offsets are equal. To retain the same instant, use WithOffsetSameInstant.
+func (d OffsetDateTime) WithOffset(offset Offset) OffsetDateTime {
+	return OffsetDateTime{v: d.v, o: int64(offset)}
+}
+
+// WithOffsetSameInstant returns the same instant as d, adjusted to the supplied offset. It is equivalent to In.
+func (d OffsetDateTime) WithOffsetSameInstant(offset Offset) OffsetDateTime {
+	return d.In(offset)
+}
+
 // Add returns the datetime d+v.
 // This function panics if the resulting datetime would fall outside of the allowed range.
 func (d OffsetDateTime) Add(v Duration) OffsetDateTime {
@@ -98,6 +221,16 @@ func (d OffsetDateTime) CanAdd(v Duration) bool {
 	return err == nil
 }
 
+// AddChecked returns the datetime d+v, in the same manner as Add.
+// Instead of panicking, it returns an error matching ErrOutOfRange if the result cannot be represented.
+func (d OffsetDateTime) AddChecked(v Duration) (OffsetDateTime, error) {
+	out, err := addDurationToBigDate(d.v, v)
+	if err != nil {
+		return OffsetDateTime{}, err
+	}
+	return OffsetDateTime{v: out, o: d.o}, nil
+}
+
 // AddDate returns the datetime corresponding to adding the given number of years, months, and days to d.
 // This function panic if the resulting datetime would fall outside of the allowed date range.
 func (d OffsetDateTime) AddDate(years, months, days int) OffsetDateTime {
@@ -114,6 +247,92 @@ func (d OffsetDateTime) CanAddDate(years, months, days int) bool {
 	return err == nil
 }
 
+// AddDateChecked returns the datetime corresponding to adding the given number of years, months, and days to d.
+// Instead of panicking, it returns an error matching ErrOutOfRange if the result cannot be represented.
+func (d OffsetDateTime) AddDateChecked(years, months, days int) (OffsetDateTime, error) {
+	out, err := addDateToBigDate(d.v, years, months, days)
+	if err != nil {
+		return OffsetDateTime{}, err
+	}
+	return OffsetDateTime{v: out, o: d.o}, nil
+}
+
+// With returns the datetime with the date returned by applying adj to the date of d, and the same time of day and offset.
+func (d OffsetDateTime) With(adj Adjuster) OffsetDateTime {
+	date, time := splitDateAndTime(d.v)
+	return OffsetDateTime{v: makeDateTime(int64(adj(LocalDate(date))), time), o: d.o}
+}
+
+// Truncate returns the result of rounding d down to the start of the unit u in which it occurs,
+// such as the start of the hour, the Monday of the ISO week, or the 1st of the month, observed in the offset of d.
+// This function panics if u is not supported.
+func (d OffsetDateTime) Truncate(u Unit) OffsetDateTime {
+	return OffsetDateTime{v: mustRoundBigDate(roundBigDate(d.v, u, roundFloor)), o: d.o}
+}
+
+// Round returns the result of rounding d to the nearest start of the unit u, as described for Truncate.
+// Halfway values are rounded up. This function panics if u is not supported, or if the result is out of range.
+func (d OffsetDateTime) Round(u Unit) OffsetDateTime {
+	return OffsetDateTime{v: mustRoundBigDate(roundBigDate(d.v, u, roundNearest)), o: d.o}
+}
+
+// Ceil returns the result of rounding d up to the start of the unit u, as described for Truncate.
+// This function panics if u is not supported, or if the result is out of range.
+func (d OffsetDateTime) Ceil(u Unit) OffsetDateTime {
+	return OffsetDateTime{v: mustRoundBigDate(roundBigDate(d.v, u, roundCeil)), o: d.o}
+}
+
+// TruncateExtent returns the result of rounding d down to a multiple of step, counted from the supplied anchor.
+// This function panics if step is not positive, or if anchor is not valid.
+func (d OffsetDateTime) TruncateExtent(step Extent, anchor Anchor) OffsetDateTime {
+	return OffsetDateTime{v: d.roundToStep(step, anchor, roundFloor), o: d.o}
+}
+
+// RoundExtent returns the result of rounding d to the nearest multiple of step, counted from the supplied anchor.
+// Halfway values are rounded up. This function panics if step is not positive, if anchor is not valid,
+// or if the result is out of range.
+func (d OffsetDateTime) RoundExtent(step Extent, anchor Anchor) OffsetDateTime {
+	return OffsetDateTime{v: d.roundToStep(step, anchor, roundNearest), o: d.o}
+}
+
+// CeilExtent returns the result of rounding d up to a multiple of step, counted from the supplied anchor.
+// This function panics if step is not positive, if anchor is not valid, or if the result is out of range.
+func (d OffsetDateTime) CeilExtent(step Extent, anchor Anchor) OffsetDateTime {
+	return OffsetDateTime{v: d.roundToStep(step, anchor, roundCeil), o: d.o}
+}
+
+func (d OffsetDateTime) roundToStep(step Extent, anchor Anchor, mode roundMode) big.Int {
+	// The Unix epoch is an instant, and so it occurs at a local time equal to the offset.
+	return mustRoundBigDate(roundBigDateToStep(d.v, step, *big.NewInt(d.o), mustMidnight(anchor), mode))
+}
+
+// Plus returns the datetime corresponding to adding n of the unit u to d.
+// Units of time, including UnitDay, are added as a fixed duration. Units of months or longer are added in the same manner as AddDate.
+// This function panics if u is not supported, or if the resulting datetime cannot be represented.
+func (d OffsetDateTime) Plus(n int64, u Unit) OffsetDateTime {
+	out, err := plusBigDate(d.v, n, u)
+	if err != nil {
+		panic(err.Error())
+	}
+	return OffsetDateTime{v: out, o: d.o}
+}
+
+// Minus returns the datetime corresponding to subtracting n of the unit u from d, in the same manner as Plus.
+func (d OffsetDateTime) Minus(n int64, u Unit) OffsetDateTime {
+	return d.Plus(-n, u)
+}
+
+// Until returns the number of whole units u from d until d2, which is negative if d2 is before d.
+// Months and larger units are counted in the same manner as BetweenDateTimes, after d2 is converted to the offset of d.
+// This function panics if u is not supported, or if the result cannot be represented.
+func (d OffsetDateTime) Until(d2 OffsetDateTime, u Unit) int64 {
+	out, err := untilBigDate(d.v, d2.In(d.Offset()).v, u)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
 // Sub returns the duration d-u.
 func (d OffsetDateTime) Sub(u OffsetDateTime) Duration {
 	out := new(big.Int).Set(&d.v)
@@ -123,6 +342,13 @@ func (d OffsetDateTime) Sub(u OffsetDateTime) Duration {
 	return Duration{v: *out}
 }
 
+// BetweenOffsetDateTimes returns the period and duration between the datetimes a and b, in the same manner as BetweenDateTimes.
+// The calculation is performed in the offset of a, such that a.AddDate(years, months, days).Add(d) represents
+// the same instant as b.
+func BetweenOffsetDateTimes(a, b OffsetDateTime) (Period, Duration) {
+	return betweenDateTimes(a.v, bigDateToOffset(b.v, b.o, a.o))
+}
+
 func (d OffsetDateTime) String() string {
 	date, time := splitDateAndTime(d.v)
 	hour, min, sec, nsec := fromTime(time)
@@ -157,6 +383,17 @@ func (d *OffsetDateTime) Parse(layout, value string) error {
 	return nil
 }
 
+// utc returns the date-time represented by d at the UTC offset.
+func (d OffsetDateTime) utc() big.Int {
+	return bigDateToOffset(d.v, d.o, 0)
+}
+
+// compareUTC is like Compare, but takes the offsets of d and d2 into account.
+func (d OffsetDateTime) compareUTC(d2 OffsetDateTime) int {
+	v, v2 := d.utc(), d2.utc()
+	return v.Cmp(&v2)
+}
+
 func (d OffsetDateTime) get() (dv, tv, ov *int64) {
 	_dv, _tv := splitDateAndTime(d.v)
 	return &_dv, &_tv, &d.o
@@ -245,3 +245,41 @@ func TestOffsetDateTime_Sub(t *testing.T) {
 		})
 	}
 }
+
+func TestBetweenOffsetDateTimes(t *testing.T) {
+	a := chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 2, 0)
+	b := chrono.OffsetDateTimeOf(2020, chrono.April, 18, 11, 0, 0, 0, 0, 0)
+
+	p, d := chrono.BetweenOffsetDateTimes(a, b)
+	if expected := (chrono.Period{Months: 1}); !p.Equal(expected) {
+		t.Errorf("period = %s, want %s", p, expected)
+	}
+
+	if expected := chrono.DurationOf(1 * chrono.Hour); d.Compare(expected) != 0 {
+		t.Errorf("duration = %s, want %s", d, expected)
+	}
+}
+
+func TestOffsetDateTime_fields(t *testing.T) {
+	dt := chrono.OffsetDateTimeOf(2020, chrono.January, 31, 23, 45, 30, 0, 2, 0)
+	if dt.Year() != 2020 || dt.Month() != chrono.January || dt.Day() != 31 || dt.Hour() != 23 || dt.Minute() != 45 || dt.Second() != 30 {
+		t.Errorf("incorrect fields of %s", dt)
+	}
+
+	for _, tt := range []struct {
+		name     string
+		out      chrono.OffsetDateTime
+		expected string
+	}{
+		{"WithMonth clamped", dt.WithMonth(chrono.February), "2020-02-29 23:45:30+02:00"},
+		{"WithHour", dt.WithHour(1), "2020-01-31 01:45:30+02:00"},
+		{"WithOffset", dt.WithOffset(chrono.OffsetOf(-3, 0)), "2020-01-31 23:45:30-03:00"},
+		{"WithOffsetSameInstant", dt.WithOffsetSameInstant(chrono.OffsetOf(-3, 0)), "2020-01-31 18:45:30-03:00"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if str := tt.out.String(); str != tt.expected {
+				t.Errorf("out = %s, want %s", str, tt.expected)
+			}
+		})
+	}
+}
@@ -20,6 +20,17 @@ func OffsetTimeOf(hour, min, sec, nsec, offsetHours, offsetMins int) OffsetTime
 	}
 }
 
+// NewOffsetTime returns an OffsetTime that represents the specified hour, minute, second,
+// and nanosecond offset within the specified second, in the same manner as OffsetTimeOf.
+// Instead of panicking, it returns an error matching ErrOutOfRange if an invalid time is specified.
+func NewOffsetTime(hour, min, sec, nsec, offsetHours, offsetMins int) (OffsetTime, error) {
+	v, err := makeTime(hour, min, sec, nsec)
+	if err != nil {
+		return OffsetTime{}, err
+	}
+	return OffsetTime{v: v, o: makeOffset(offsetHours, offsetMins)}, nil
+}
+
 // OfTimeOffset combines a LocalTime and Offset into an OffsetTime.
 func OfTimeOffset(time LocalTime, offset Offset) OffsetTime {
 	return OffsetTime{
@@ -47,6 +58,57 @@ func (t OffsetTime) Nanosecond() int {
 	return timeNanoseconds(t.v)
 }
 
+// Hour returns the hour specified by t, normalized to the 24-hour clock as described for Clock.
+func (t OffsetTime) Hour() int {
+	hour, _, _, _ := fromTime(t.v)
+	return hour
+}
+
+// Minute returns the minute offset within the hour specified by t, in the range [0, 59].
+func (t OffsetTime) Minute() int {
+	_, min, _, _ := fromTime(t.v)
+	return min
+}
+
+// Second returns the second offset within the minute specified by t, in the range [0, 59].
+func (t OffsetTime) Second() int {
+	_, _, sec, _ := fromTime(t.v)
+	return sec
+}
+
+// WithHour returns t with its hour replaced, which may be a business hour up to 99.
+// This function panics if hour is not in the range [0, 99].
+func (t OffsetTime) WithHour(hour int) OffsetTime {
+	return OffsetTime{v: withTimeFields(t.v, func(_, min, sec, nsec int) (int, int, int, int) { return hour, min, sec, nsec }), o: t.o}
+}
+
+// WithMinute returns t with its minute replaced. This function panics if min is not in the range [0, 59].
+func (t OffsetTime) WithMinute(min int) OffsetTime {
+	return OffsetTime{v: withTimeFields(t.v, func(hour, _, sec, nsec int) (int, int, int, int) { return hour, min, sec, nsec }), o: t.o}
+}
+
+// WithSecond returns t with its second replaced. This function panics if sec is not in the range [0, 59].
+func (t OffsetTime) WithSecond(sec int) OffsetTime {
+	return OffsetTime{v: withTimeFields(t.v, func(hour, min, _, nsec int) (int, int, int, int) { return hour, min, sec, nsec }), o: t.o}
+}
+
+// WithNanosecond returns t with its nanosecond offset within the second replaced.
+// This function panics if nsec is not in the range [0, 999999999].
+func (t OffsetTime) WithNanosecond(nsec int) OffsetTime {
+	return OffsetTime{v: withTimeFields(t.v, func(hour, min, sec, _ int) (int, int, int, int) { return hour, min, sec, nsec }), o: t.o}
+}
+
+// WithOffset returns t with its offset replaced, and the same local time.
+// The result therefore represents a different time, unless the offsets are equal. To retain the same time, use WithOffsetSameInstant.
+func (t OffsetTime) WithOffset(offset Offset) OffsetTime {
+	return OffsetTime{v: t.v, o: int64(offset)}
+}
+
+// WithOffsetSameInstant returns the same time as t, adjusted to the supplied offset. It is equivalent to In.
+func (t OffsetTime) WithOffsetSameInstant(offset Offset) OffsetTime {
+	return t.In(offset)
+}
+
 // Sub returns the duration t-u.
 func (t OffsetTime) Sub(u OffsetTime) Extent {
 	return Extent(t.utc() - u.utc())
@@ -73,6 +135,16 @@ func (t OffsetTime) CanAdd(v Extent) bool {
 	return err == nil
 }
 
+// AddChecked returns the time t+v, in the same manner as Add.
+// Instead of panicking, it returns an error matching ErrOutOfRange if the result exceeds the maximum representable time.
+func (t OffsetTime) AddChecked(v Extent) (OffsetTime, error) {
+	out, err := addTime(t.v, int64(v))
+	if err != nil {
+		return OffsetTime{}, err
+	}
+	return OffsetTime{v: out, o: t.o}, nil
+}
+
 // Compare compares t with t2. If t is before t2, it returns -1;
 // if t is after t2, it returns 1; if they're the same, it returns 0.
 func (t OffsetTime) Compare(t2 OffsetTime) int {
@@ -205,3 +205,26 @@ func TestOffsetTime_In(t *testing.T) {
 		})
 	}
 }
+
+func TestOffsetTime_fields(t *testing.T) {
+	tm := chrono.OffsetTimeOf(12, 30, 59, 12345678, 2, 0)
+	if tm.Hour() != 12 || tm.Minute() != 30 || tm.Second() != 59 {
+		t.Errorf("fields = %d, %d, %d", tm.Hour(), tm.Minute(), tm.Second())
+	}
+
+	for _, tt := range []struct {
+		name     string
+		out      chrono.OffsetTime
+		expected string
+	}{
+		{"WithHour", tm.WithHour(9).WithNanosecond(0), "09:30:59+02:00"},
+		{"WithOffset", tm.WithNanosecond(0).WithOffset(chrono.OffsetOf(-1, 0)), "12:30:59-01:00"},
+		{"WithOffsetSameInstant", tm.WithSecond(0).WithNanosecond(0).WithOffsetSameInstant(chrono.OffsetOf(-1, 0)), "09:30:00-01:00"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if str := tt.out.String(); str != tt.expected {
+				t.Errorf("out = %s, want %s", str, tt.expected)
+			}
+		})
+	}
+}
@@ -9,40 +9,520 @@ import (
 
 // Period represents an amount of time in years, months, weeks and days.
 // A period is not a measurable quantity since the lengths of these components is ambiguous.
+//
+// Each component is an exact integer. As permitted by ISO 8601, the smallest component of a period may
+// additionally have a decimal fraction, such as P2.5Y or P1Y0.5D, which is stored in Fraction and FractionUnit.
+// A fraction must not apply to a larger unit than any other non-zero component, such as in P0.5Y1M,
+// and operations that would produce such a period return an error or panic.
+// When a period is added to a date, a fractional year is converted to whole months, and a fractional week to whole days.
+// When added to a datetime, a fractional day (including any part of a day remaining from a fractional week)
+// is then added as a duration, where each day is 24 hours long. Any remaining fraction,
+// including any fraction of a month, is truncated.
 type Period struct {
-	Years  float32
-	Months float32
-	Weeks  float32
-	Days   float32
+	Years  int
+	Months int
+	Weeks  int
+	Days   int
+
+	// Fraction is a decimal fraction of the unit given by FractionUnit, in billionths, such that 500000000
+	// represents one half. It must be greater than -1000000000 and less than 1000000000,
+	// and should have the same sign as the component to which it applies.
+	Fraction int
+	// FractionUnit is the unit to which Fraction applies, being one of UnitYear, UnitMonth, UnitWeek or UnitDay.
+	// If any other unit is specified, Fraction is ignored.
+	FractionUnit Unit
 }
 
+const fractionScale = 1e9
+
 // Equal reports whether p and p2 represent the same period of time.
 func (p Period) Equal(p2 Period) bool {
-	return p2.Years == p.Years && p2.Months == p.Months && p2.Weeks == p.Weeks && p2.Days == p.Days
+	f1, u1 := p.fraction()
+	f2, u2 := p2.fraction()
+	return p2.Years == p.Years && p2.Months == p.Months && p2.Weeks == p.Weeks && p2.Days == p.Days && f1 == f2 && u1 == u2
 }
 
-// String returns a string formatted according to ISO 8601.
-// The output consists of only the period component - the time component is never included.
+// fraction returns the fraction of p and its unit, or zero values if p has no fraction.
+func (p Period) fraction() (int, Unit) {
+	switch p.FractionUnit {
+	case UnitYear, UnitMonth, UnitWeek, UnitDay:
+		if p.Fraction != 0 {
+			return p.Fraction, p.FractionUnit
+		}
+	}
+	return 0, 0
+}
+
+// component returns a pointer to the component of p that corresponds to the unit u, or nil if there is none.
+func (p *Period) component(u Unit) *int {
+	switch u {
+	case UnitYear:
+		return &p.Years
+	case UnitMonth:
+		return &p.Months
+	case UnitWeek:
+		return &p.Weeks
+	case UnitDay:
+		return &p.Days
+	}
+	return nil
+}
+
+// checkFraction returns an error if p has a fraction that applies to a unit larger than one of its other non-zero components.
+func (p Period) checkFraction() error {
+	f, u := p.fraction()
+	if f == 0 {
+		return nil
+	}
+
+	for _, c := range []struct {
+		unit  Unit
+		value int
+	}{
+		{UnitMonth, p.Months},
+		{UnitWeek, p.Weeks},
+		{UnitDay, p.Days},
+	} {
+		if c.unit < u && c.value != 0 {
+			return fmt.Errorf("only the smallest component can have a fraction")
+		}
+	}
+	return nil
+}
+
+// normalizeFraction carries any whole units of the fraction into its component,
+// and ensures that the fraction has the same sign as the component.
+func (p *Period) normalizeFraction() {
+	f, u := p.fraction()
+	if f == 0 {
+		p.Fraction, p.FractionUnit = 0, 0
+		return
+	}
+
+	c := p.component(u)
+	*c += f / fractionScale
+	f %= fractionScale
+
+	if *c > 0 && f < 0 {
+		*c--
+		f += fractionScale
+	} else if *c < 0 && f > 0 {
+		*c++
+		f -= fractionScale
+	}
+
+	p.Fraction = f
+	if f == 0 {
+		p.FractionUnit = 0
+	}
+}
+
+// IsZero reports whether every component of p is zero.
+func (p Period) IsZero() bool {
+	f, _ := p.fraction()
+	return p.Years == 0 && p.Months == 0 && p.Weeks == 0 && p.Days == 0 && f == 0
+}
+
+// IsNegative reports whether any component of p is negative.
+func (p Period) IsNegative() bool {
+	f, _ := p.fraction()
+	return p.Years < 0 || p.Months < 0 || p.Weeks < 0 || p.Days < 0 || f < 0
+}
+
+// Add returns the sum of p and p2, adding each component separately.
+// This function panics if p and p2 both have fractions that apply to different units,
+// if the resulting fraction would not apply to the smallest non-zero component, or if a component would overflow.
+// Use CanAdd to test whether a panic would occur.
+func (p Period) Add(p2 Period) Period {
+	out, err := p.add(p2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanAdd returns false if Add would panic if passed the same argument.
+func (p Period) CanAdd(p2 Period) bool {
+	_, err := p.add(p2)
+	return err == nil
+}
+
+// AddChecked returns the sum of p and p2, in the same manner as Add, but returns an error instead of panicking.
+func (p Period) AddChecked(p2 Period) (Period, error) {
+	return p.add(p2)
+}
+
+func (p Period) add(p2 Period) (Period, error) {
+	f1, u1 := p.fraction()
+	f2, u2 := p2.fraction()
+	if f1 != 0 && f2 != 0 && u1 != u2 {
+		return Period{}, fmt.Errorf("cannot add fractions of %s and %s", u1, u2)
+	} else if f1 == 0 {
+		u1 = u2
+	}
+
+	out := Period{Fraction: f1 + f2, FractionUnit: u1}
+	for _, c := range []struct {
+		out    *int
+		v1, v2 int
+	}{
+		{&out.Years, p.Years, p2.Years},
+		{&out.Months, p.Months, p2.Months},
+		{&out.Weeks, p.Weeks, p2.Weeks},
+		{&out.Days, p.Days, p2.Days},
+	} {
+		v, under, over := addInt64(int64(c.v1), int64(c.v2))
+		if under || over || int64(int(v)) != v {
+			return Period{}, errOverflow("period component overflow")
+		}
+		*c.out = int(v)
+	}
+
+	out.normalizeFraction()
+	return out, out.checkFraction()
+}
+
+// Sub returns the result of p-p2, subtracting each component separately.
+// This function panics in the same circumstances as Add.
+// Use CanSub to test whether a panic would occur.
+func (p Period) Sub(p2 Period) Period {
+	return p.Add(p2.neg())
+}
+
+// CanSub returns false if Sub would panic if passed the same argument.
+func (p Period) CanSub(p2 Period) bool {
+	return p.CanAdd(p2.neg())
+}
+
+// SubChecked returns the result of p-p2, in the same manner as Sub, but returns an error instead of panicking.
+func (p Period) SubChecked(p2 Period) (Period, error) {
+	return p.add(p2.neg())
+}
+
+// Neg returns p with each of its components negated.
+// If the components of p have the same sign, the result is formatted as p preceded by a '-' character, or with it removed.
+func (p Period) Neg() Period {
+	return p.neg()
+}
+
+func (p Period) neg() Period {
+	f, u := p.fraction()
+	return Period{Years: -p.Years, Months: -p.Months, Weeks: -p.Weeks, Days: -p.Days, Fraction: -f, FractionUnit: u}
+}
+
+// Mul returns p with each of its components multiplied by v.
+// Any whole units that result from multiplying the fraction are carried into its component.
+// This function panics if a component would overflow, or if the fraction of p does not apply to its smallest non-zero component.
+// Use CanMul to test whether a panic would occur.
+func (p Period) Mul(v int) Period {
+	out, err := p.mul(v)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanMul returns false if Mul would panic if passed the same argument.
+func (p Period) CanMul(v int) bool {
+	_, err := p.mul(v)
+	return err == nil
+}
+
+// MulChecked returns p multiplied by v, in the same manner as Mul, but returns an error instead of panicking.
+func (p Period) MulChecked(v int) (Period, error) {
+	return p.mul(v)
+}
+
+func (p Period) mul(v int) (Period, error) {
+	if err := p.checkFraction(); err != nil {
+		return Period{}, err
+	}
+
+	var out Period
+	for _, c := range []struct {
+		out *int
+		v   int
+	}{
+		{&out.Years, p.Years},
+		{&out.Months, p.Months},
+		{&out.Weeks, p.Weeks},
+		{&out.Days, p.Days},
+	} {
+		prod, ok := mulInt64(int64(c.v), int64(v))
+		if !ok || int64(int(prod)) != prod {
+			return Period{}, errOverflow("period component overflow")
+		}
+		*c.out = int(prod)
+	}
+
+	if f, u := p.fraction(); f != 0 {
+		c := out.component(u)
+		var err error
+		if *c, out.Fraction, err = mulFraction(*c, f, int64(v)); err != nil {
+			return Period{}, err
+		}
+		out.FractionUnit = u
+		out.normalizeFraction()
+	}
+	return out, nil
+}
+
+// mulFraction multiplies the fraction f by n and adds its whole part to the component c,
+// returning the resulting component and the remaining fraction.
+func mulFraction(c, f int, n int64) (int, int, error) {
+	prod, ok := mulInt64(int64(f), n)
+	if !ok {
+		return 0, 0, errOverflow("period component overflow")
+	}
+
+	out, err := addComponent(c, prod/fractionScale)
+	if err != nil {
+		return 0, 0, err
+	}
+	return out, int(prod % fractionScale), nil
+}
+
+// mulAddComponent returns v*n+c, or an error if the result cannot be represented by a component of Period.
+func mulAddComponent(v int, n int64, c int) (int, error) {
+	prod, ok := mulInt64(int64(v), n)
+	if !ok {
+		return 0, errOverflow("period component overflow")
+	}
+	return addComponent(c, prod)
+}
+
+// addComponent returns c+v, or an error if the result cannot be represented by a component of Period.
+func addComponent(c int, v int64) (int, error) {
+	sum, under, over := addInt64(int64(c), v)
+	if under || over || int64(int(sum)) != sum {
+		return 0, errOverflow("period component overflow")
+	}
+	return int(sum), nil
+}
+
+// TotalMonths returns the number of months represented by the years and months of p, where each year is 12 months.
+// Weeks and days are ignored, since they cannot be converted to months without a reference date.
+func (p Period) TotalMonths() float64 {
+	out := float64(p.Years)*12 + float64(p.Months)
+	switch f, u := p.fraction(); u {
+	case UnitYear:
+		out += float64(f) * 12 / fractionScale
+	case UnitMonth:
+		out += float64(f) / fractionScale
+	}
+	return out
+}
+
+// TotalDays returns the number of days represented by the weeks and days of p, where each week is 7 days.
+// Years and months are ignored, since they cannot be converted to days without a reference date.
+func (p Period) TotalDays() float64 {
+	out := float64(p.Weeks)*7 + float64(p.Days)
+	switch f, u := p.fraction(); u {
+	case UnitWeek:
+		out += float64(f) * 7 / fractionScale
+	case UnitDay:
+		out += float64(f) / fractionScale
+	}
+	return out
+}
+
+// Normalized returns p with its years and months expressed as whole years and fewer than 12 months,
+// and its weeks expressed as days. For example, P1Y14M2W1D normalizes to P2Y2M15D.
+// Years and months are given the same sign, so P1Y-2M normalizes to P10M.
+// A fractional year is converted to months, and a fractional week to days, so P2.55Y normalizes to P2Y6.6M, and P1.5W to P10.5D.
+// This function panics if the fraction of p does not apply to its smallest non-zero component,
+// or if the total number of months or days cannot be represented.
+func (p Period) Normalized() Period {
+	out, err := p.normalized()
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+func (p Period) normalized() (Period, error) {
+	if err := p.checkFraction(); err != nil {
+		return Period{}, err
+	}
+
+	months, err := mulAddComponent(p.Years, 12, p.Months)
+	if err != nil {
+		return Period{}, err
+	}
+
+	days, err := mulAddComponent(p.Weeks, 7, p.Days)
+	if err != nil {
+		return Period{}, err
+	}
+
+	out := Period{Months: months, Days: days}
+	switch f, u := p.fraction(); u {
+	case UnitYear:
+		out.Months, out.Fraction, err = mulFraction(out.Months, f, 12)
+		out.FractionUnit = UnitMonth
+	case UnitMonth:
+		out.Months, out.Fraction, err = mulFraction(out.Months, f, 1)
+		out.FractionUnit = UnitMonth
+	case UnitWeek:
+		out.Days, out.Fraction, err = mulFraction(out.Days, f, 7)
+		out.FractionUnit = UnitDay
+	case UnitDay:
+		out.Days, out.Fraction, err = mulFraction(out.Days, f, 1)
+		out.FractionUnit = UnitDay
+	}
+	if err != nil {
+		return Period{}, err
+	}
+	out.normalizeFraction()
+
+	out.Years = out.Months / 12
+	out.Months %= 12
+	return out, nil
+}
+
+// NormalizedWeeks returns p normalized as by Normalized, except that whole multiples of 7 days are expressed in Weeks.
+// For example, P17D normalizes to P2W3D.
+func (p Period) NormalizedWeeks() Period {
+	out := p.Normalized()
+	out.Weeks = out.Days / 7
+	out.Days %= 7
+	return out
+}
+
+// dateUnits returns the whole numbers of years, months and days represented by p, as accepted by AddDate,
+// along with the remaining fraction of a day. Each week is counted as 7 days.
+// Fractions are applied as described for Period. An error is returned if the number of months or days cannot be represented.
+func (p Period) dateUnits() (years, months, days int, rem Extent, err error) {
+	if days, err = mulAddComponent(p.Weeks, 7, p.Days); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	years, months = p.Years, p.Months
+
+	var f int
+	switch _f, u := p.fraction(); u {
+	case UnitYear:
+		months, _, err = mulFraction(months, _f, 12)
+	case UnitWeek:
+		days, f, err = mulFraction(days, _f, 7)
+	case UnitDay:
+		days, f, err = mulFraction(days, _f, 1)
+	}
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	return years, months, days, Extent(f) * (24 * Hour / fractionScale), nil
+}
+
+// String returns a string formatted according to ISO 8601, as by Format.
+// Since ISO 8601 cannot represent a period whose components have different signs,
+// such a period is instead formatted in the form %!Period(P1Y-2M), in which each negative component is preceded by a '-' character.
 func (p Period) String() string {
-	if p.Years == 0 && p.Months == 0 && p.Weeks == 0 && p.Days == 0 {
-		return "P0D"
+	out, err := p.format()
+	if err != nil {
+		return "%!Period(P" + p.formatComponents(true) + ")"
 	}
+	return out
+}
 
-	out := "P"
-	if p.Years != 0 {
-		out += strconv.FormatFloat(math.Abs(float64(p.Years)), 'f', -1, 32) + "Y"
+// Format returns a string formatted according to ISO 8601.
+// The output consists of only the period component - the time component is never included.
+// If the components of p are negative, the string is preceded by a '-' character.
+// This function panics if the components of p have different signs, or if a component other than the smallest has a fraction,
+// since such a period cannot be represented in ISO 8601. Use CanFormat to test whether a panic would occur.
+func (p Period) Format() string {
+	out, err := p.format()
+	if err != nil {
+		panic(err.Error())
 	}
+	return out
+}
 
-	if p.Months != 0 {
-		out += strconv.FormatFloat(math.Abs(float64(p.Months)), 'f', -1, 32) + "M"
+// CanFormat returns false if Format would panic.
+func (p Period) CanFormat() bool {
+	_, err := p.format()
+	return err == nil
+}
+
+// FormatChecked returns a string formatted according to ISO 8601, in the same manner as Format,
+// but returns an error instead of panicking.
+func (p Period) FormatChecked() (string, error) {
+	return p.format()
+}
+
+func (p Period) format() (string, error) {
+	neg, err := p.sign()
+	if err != nil {
+		return "", err
+	} else if err := p.checkFraction(); err != nil {
+		return "", err
 	}
 
-	if p.Weeks != 0 {
-		out += strconv.FormatFloat(math.Abs(float64(p.Weeks)), 'f', -1, 32) + "W"
+	out := "P" + p.formatComponents(false)
+	if neg {
+		out = "-" + out
+	}
+	return out, nil
+}
+
+// sign reports whether the non-zero components of p are negative.
+// An error is returned if they have different signs.
+func (p Period) sign() (neg bool, err error) {
+	f, _ := p.fraction()
+
+	var pos bool
+	for _, v := range []int{p.Years, p.Months, p.Weeks, p.Days, f} {
+		pos, neg = pos || v > 0, neg || v < 0
 	}
 
-	if p.Days != 0 {
-		out += strconv.FormatFloat(math.Abs(float64(p.Days)), 'f', -1, 32) + "D"
+	if pos && neg {
+		return false, fmt.Errorf("period has components with different signs")
+	}
+	return neg, nil
+}
+
+// formatComponents returns the components of p followed by their designators, such as 1Y2M, or 0D if p is zero.
+// If signed is false, the absolute value of each component is used, otherwise each negative component is preceded by a '-' character.
+func (p Period) formatComponents(signed bool) string {
+	if p.IsZero() {
+		return "0D"
+	}
+
+	f, u := p.fraction()
+	var out string
+	for _, c := range []struct {
+		unit       Unit
+		value      int
+		designator string
+	}{
+		{UnitYear, p.Years, "Y"},
+		{UnitMonth, p.Months, "M"},
+		{UnitWeek, p.Weeks, "W"},
+		{UnitDay, p.Days, "D"},
+	} {
+		if c.value != 0 || u == c.unit {
+			if signed && (c.value < 0 || (c.value == 0 && f < 0)) {
+				out += "-"
+			}
+			out += formatPeriodValue(c.value, f, u == c.unit) + c.designator
+		}
+	}
+	return out
+}
+
+// formatPeriodValue returns the absolute value of v, followed by the fraction f if hasFraction is true.
+func formatPeriodValue(v, f int, hasFraction bool) string {
+	abs := uint64(v)
+	if v < 0 {
+		abs = uint64(-(v + 1)) + 1
+	}
+
+	out := strconv.FormatUint(abs, 10)
+
+	if hasFraction {
+		if f < 0 {
+			f = -f
+		}
+		out += "." + strings.TrimRight(fmt.Sprintf("%09d", f), "0")
 	}
 	return out
 }
@@ -50,47 +530,60 @@ func (p Period) String() string {
 // Parse the period portion of an ISO 8601 duration.
 // This function supports the ISO 8601-2 extension, which allows weeks (W) to appear in combination
 // with years, months, and days, such as P3W1D. Additionally, it allows a sign character to appear
-// at the start of string, such as +P1M, or -P1M.
+// at the start of string, such as +P1M, or -P1M, in which case each component is negated.
+// Only the last component may have a decimal fraction, which can contain up to 9 significant digits.
 func (p *Period) Parse(s string) error {
-	years, months, weeks, days, _, _, _, err := parseDuration(s, true, false)
+	out, _, _, neg, err := parseDuration(s, true, false)
 	if err != nil {
 		return err
 	}
 
-	p.Years = years
-	p.Months = months
-	p.Weeks = weeks
-	p.Days = days
+	if neg {
+		out = out.neg()
+	}
+	*p = out
 	return nil
 }
 
 // FormatDuration formats a combined period and duration to a complete ISO 8601 duration.
+// The period component is always included. If the period and duration are negative, the string is preceded by a '-' character.
+// This function panics if the period cannot be formatted, as described for Period.Format,
+// or if the period and duration have different signs.
 func FormatDuration(p Period, d Duration, exclusive ...Designator) string {
-	out := p.String()
+	if _, err := p.format(); err != nil {
+		panic(err.Error())
+	}
 
-	t, neg := d.format(exclusive...)
-	out += t
+	neg := p.IsNegative()
+	t, negDuration := d.format(exclusive...)
+	if d.v.Sign() != 0 && !p.IsZero() && neg != negDuration {
+		panic("period and duration have different signs")
+	}
 
-	if neg {
+	out := "P" + p.formatComponents(false) + t
+	if neg || negDuration {
 		out = "-" + out
 	}
 	return out
 }
 
 // ParseDuration parses a complete ISO 8601 duration.
+// A leading '-' character negates both the period and the duration.
 func ParseDuration(s string) (Period, Duration, error) {
-	years, months, weeks, days, secs, nsec, neg, err := parseDuration(s, true, true)
-	return Period{
-		Years:  years,
-		Months: months,
-		Weeks:  weeks,
-		Days:   days,
-	}, makeDuration(secs, nsec, neg), err
+	p, secs, nsec, neg, err := parseDuration(s, true, true)
+	if err != nil {
+		return Period{}, Duration{}, err
+	}
+
+	if neg {
+		p = p.neg()
+	}
+	return p, makeDuration(secs, nsec, neg), nil
 }
 
-func parseDuration(s string, parsePeriod, parseTime bool) (years, months, weeks, days float32, secs int64, nsec uint32, neg bool, err error) {
+func parseDuration(s string, parsePeriod, parseTime bool) (p Period, secs int64, nsec uint32, neg bool, err error) {
 	if len(s) == 0 {
-		return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("empty string")
+		return Period{}, 0, 0, false, fmt.Errorf("empty string")
 	}
 
 	offset := 1
@@ -100,7 +593,7 @@ func parseDuration(s string, parsePeriod, parseTime bool) (years, months, weeks,
 		neg = true
 		offset++
 	} else if s[0] != 'P' {
-		return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("expecting 'P'")
+		return Period{}, 0, 0, false, fmt.Errorf("expecting 'P'")
 	}
 
 	var value int
@@ -117,49 +610,61 @@ func parseDuration(s string, parsePeriod, parseTime bool) (years, months, weeks,
 				if !onTime {
 					onTime = true
 				} else {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("unexpected '%c', expecting digit", s[i])
+					return Period{}, 0, 0, false, fmt.Errorf("unexpected '%c', expecting digit", s[i])
 				}
 			} else {
-				return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("unexpected '%c', expecting digit or 'T'", s[i])
+				return Period{}, 0, 0, false, fmt.Errorf("unexpected '%c', expecting digit or 'T'", s[i])
 			}
 		} else {
 			if !onTime {
 				if !parsePeriod {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("cannot parse duration as Duration")
+					return Period{}, 0, 0, false, fmt.Errorf("cannot parse duration as Duration")
 				} else if digit {
 					continue
 				}
 
-				v, err := parseFloat(s[value:i], 32)
-				if err != nil {
-					return 0, 0, 0, 0, 0, 0, false, err
-				}
-
+				var unit Unit
 				switch s[i] {
 				case 'Y':
-					years = float32(v)
+					unit = UnitYear
 				case 'M':
-					months = float32(v)
+					unit = UnitMonth
 				case 'W':
-					weeks = float32(v)
+					unit = UnitWeek
 				case 'D':
-					days = float32(v)
+					unit = UnitDay
 				default:
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("unexpected '%c', expecting 'Y', 'M', 'W', or 'D'", s[i])
+					return Period{}, 0, 0, false, fmt.Errorf("unexpected '%c', expecting 'Y', 'M', 'W', or 'D'", s[i])
+				}
+
+				if p.Fraction != 0 {
+					return Period{}, 0, 0, false, fmt.Errorf("only the smallest component can have a fraction")
+				}
+
+				whole, frac, _ := strings.Cut(strings.ReplaceAll(s[value:i], ",", "."), ".")
+				v, err := periodOfDecimal(whole, frac, unit)
+				if err != nil {
+					return Period{}, 0, 0, false, err
+				}
+
+				// Components that are repeated are replaced, rather than summed.
+				*p.component(unit) = *v.component(unit)
+				if v.Fraction != 0 {
+					p.Fraction, p.FractionUnit = v.Fraction, unit
 				}
 
 				value = 0
 				haveUnit = true
 			} else {
 				if !parseTime {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("cannot parse duration as Period")
+					return Period{}, 0, 0, false, fmt.Errorf("cannot parse duration as Period")
 				} else if digit {
 					continue
 				}
 
 				v, err := parseFloat(s[value:i], 64)
 				if err != nil {
-					return 0, 0, 0, 0, 0, 0, false, err
+					return Period{}, 0, 0, false, err
 				}
 
 				var _secs float64
@@ -175,26 +680,26 @@ func parseDuration(s string, parsePeriod, parseTime bool) (years, months, weeks,
 					_secs = math.Floor(v)
 					_nsec = uint32((v * 1e9) - (_secs * 1e9))
 				default:
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("unexpected '%c', expecting 'H', 'M' or 'S'", s[i])
+					return Period{}, 0, 0, false, fmt.Errorf("unexpected '%c', expecting 'H', 'M' or 'S'", s[i])
 				}
 
 				if _secs < math.MinInt64 {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("seconds underflow")
+					return Period{}, 0, 0, false, fmt.Errorf("seconds underflow")
 				} else if _secs > math.MaxInt64 {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("seconds overflow")
+					return Period{}, 0, 0, false, fmt.Errorf("seconds overflow")
 				}
 
 				var under, over bool
 				if secs, under, over = addInt64(secs, int64(_secs)); under {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("seconds underflow")
+					return Period{}, 0, 0, false, fmt.Errorf("seconds underflow")
 				} else if over {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("seconds overflow")
+					return Period{}, 0, 0, false, fmt.Errorf("seconds overflow")
 				}
 
 				if secs, under, over = addInt64(secs, int64(_nsec/1e9)); under {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("seconds underflow")
+					return Period{}, 0, 0, false, fmt.Errorf("seconds underflow")
 				} else if over {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("seconds overflow")
+					return Period{}, 0, 0, false, fmt.Errorf("seconds overflow")
 				}
 				nsec = _nsec % 1e9
 
@@ -205,11 +710,42 @@ func parseDuration(s string, parsePeriod, parseTime bool) (years, months, weeks,
 	}
 
 	if !haveUnit {
-		return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("expecting at least one unit")
+		return Period{}, 0, 0, false, fmt.Errorf("expecting at least one unit")
 	}
 	return
 }
 
+// periodOfDecimal returns the period represented by the supplied whole and fractional digits of the unit u.
+// Any part of the fraction that is smaller than a billionth is truncated.
+func periodOfDecimal(whole, frac string, u Unit) (Period, error) {
+	if whole == "" && frac == "" {
+		return Period{}, fmt.Errorf("invalid number")
+	}
+
+	var out Period
+	if whole != "" {
+		v, err := strconv.ParseUint(whole, 10, strconv.IntSize-1)
+		if err != nil {
+			return Period{}, fmt.Errorf("invalid number %q", whole)
+		}
+		*out.component(u) = int(v)
+	}
+
+	if frac != "" {
+		if len(frac) > 9 {
+			frac = frac[:9]
+		}
+
+		v, err := strconv.ParseUint(frac+strings.Repeat("0", 9-len(frac)), 10, 32)
+		if err != nil {
+			return Period{}, fmt.Errorf("invalid number %q", frac)
+		}
+		out.Fraction, out.FractionUnit = int(v), u
+		out.normalizeFraction()
+	}
+	return out, nil
+}
+
 func parseFloat(s string, bitSize int) (float64, error) {
 	s = strings.ReplaceAll(s, ",", ".")
 	return strconv.ParseFloat(s, bitSize)
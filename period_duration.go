@@ -0,0 +1,246 @@
+package chrono
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// PeriodDuration combines a Period and a Duration into a complete ISO 8601 duration, such as P1Y2M10DT2H30M.
+// The zero value represents a duration of zero length.
+type PeriodDuration struct {
+	Period   Period
+	Duration Duration
+}
+
+// String returns a string formatted according to ISO 8601, as by Format.
+// If pd cannot be formatted, such as because its period and duration have different signs,
+// it is instead formatted in the form %!PeriodDuration(P1Y, PT-1H), using Period.String and Duration.String.
+func (pd PeriodDuration) String() string {
+	out, err := pd.format()
+	if err != nil {
+		return "%!PeriodDuration(" + pd.Period.String() + ", " + pd.Duration.String() + ")"
+	}
+	return out
+}
+
+// Format the period and duration according to ISO 8601.
+// The period component is formatted as by Period.Format, and the duration component as by Duration.Format,
+// to which the supplied designators apply. Each component is omitted if it is zero, unless both are zero,
+// or if designators are supplied, in which case the duration component is always included.
+// If the period and duration are negative, the string is preceded by a '-' character.
+//
+// Since ISO 8601 applies a single sign to the whole string, this function panics if the period and duration
+// have different signs, or if the period cannot be formatted. Use CanFormat to test whether a panic would occur.
+func (pd PeriodDuration) Format(exclusive ...Designator) string {
+	out, err := pd.format(exclusive...)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanFormat returns false if Format would panic.
+func (pd PeriodDuration) CanFormat() bool {
+	_, err := pd.format()
+	return err == nil
+}
+
+// FormatChecked formats pd in the same manner as Format, but returns an error instead of panicking.
+func (pd PeriodDuration) FormatChecked(exclusive ...Designator) (string, error) {
+	return pd.format(exclusive...)
+}
+
+func (pd PeriodDuration) format(exclusive ...Designator) (string, error) {
+	if _, err := pd.Period.format(); err != nil {
+		return "", err
+	}
+
+	var out string
+	if !pd.Period.IsZero() {
+		out = pd.Period.formatComponents(false)
+	}
+
+	neg := pd.Period.IsNegative()
+	if pd.Duration.v.Sign() != 0 || len(exclusive) != 0 || out == "" {
+		t, negDuration := pd.Duration.format(exclusive...)
+		if pd.Duration.v.Sign() != 0 && !pd.Period.IsZero() && neg != negDuration {
+			return "", fmt.Errorf("period and duration have different signs")
+		}
+
+		out += t
+		neg = neg || negDuration
+	}
+
+	out = "P" + out
+	if neg {
+		out = "-" + out
+	}
+	return out, nil
+}
+
+// Parse a complete ISO 8601 duration, as accepted by ParseDuration, and store the value it represents in pd.
+// A leading '-' character negates both the period and the duration.
+func (pd *PeriodDuration) Parse(s string) error {
+	p, secs, nsec, neg, err := parseDuration(s, true, true)
+	if err != nil {
+		return err
+	}
+
+	pd.Period = p
+	if neg {
+		pd.Period = pd.Period.neg()
+	}
+	pd.Duration = makeDuration(secs, nsec, neg)
+	return nil
+}
+
+// Neg returns the negation of pd, in which both the period and duration are negated.
+// This function panics if the duration is MinDuration, since its negation cannot be represented.
+func (pd PeriodDuration) Neg() PeriodDuration {
+	return PeriodDuration{Period: pd.Period.neg(), Duration: pd.Duration.Neg()}
+}
+
+// Add returns the sum of pd and pd2, adding each component of their periods and durations separately.
+// If the sum of the durations would overflow the maximum duration, or underflow the minimum duration, it panics.
+// Use CanAdd to test whether a panic would occur.
+func (pd PeriodDuration) Add(pd2 PeriodDuration) PeriodDuration {
+	out, err := pd.add(pd2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanAdd returns false if Add would panic if passed the same argument.
+func (pd PeriodDuration) CanAdd(pd2 PeriodDuration) bool {
+	_, err := pd.add(pd2)
+	return err == nil
+}
+
+// AddChecked returns the sum of pd and pd2, in the same manner as Add, but returns an error instead of panicking.
+func (pd PeriodDuration) AddChecked(pd2 PeriodDuration) (PeriodDuration, error) {
+	return pd.add(pd2)
+}
+
+func (pd PeriodDuration) add(pd2 PeriodDuration) (PeriodDuration, error) {
+	d, err := pd.Duration.add(pd2.Duration)
+	if err != nil {
+		return PeriodDuration{}, err
+	}
+
+	p, err := pd.Period.add(pd2.Period)
+	if err != nil {
+		return PeriodDuration{}, err
+	}
+	return PeriodDuration{Period: p, Duration: d}, nil
+}
+
+// Mul returns pd multiplied by v, multiplying each component of the period and the duration.
+// If the resulting duration would overflow the maximum duration, or underflow the minimum duration,
+// or if the period cannot be multiplied as described for Period.Mul, it panics.
+// Use CanMul to test whether a panic would occur.
+func (pd PeriodDuration) Mul(v int) PeriodDuration {
+	out, err := pd.mul(v)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanMul returns false if Mul would panic if passed the same argument.
+func (pd PeriodDuration) CanMul(v int) bool {
+	_, err := pd.mul(v)
+	return err == nil
+}
+
+// MulChecked returns pd multiplied by v, in the same manner as Mul, but returns an error instead of panicking.
+func (pd PeriodDuration) MulChecked(v int) (PeriodDuration, error) {
+	return pd.mul(v)
+}
+
+func (pd PeriodDuration) mul(v int) (PeriodDuration, error) {
+	d, err := pd.Duration.mul(int64(v))
+	if err != nil {
+		return PeriodDuration{}, err
+	}
+
+	p, err := pd.Period.mul(v)
+	if err != nil {
+		return PeriodDuration{}, err
+	}
+	return PeriodDuration{Period: p, Duration: d}, nil
+}
+
+// AddToOffsetDateTime returns the datetime d+pd. The period is applied first using AddDate (where each week counts as 7 days),
+// and then the duration is added. A fractional component of the period is applied as described for Period.
+// An error is returned if the resulting datetime would fall outside of the allowed range.
+func (pd PeriodDuration) AddToOffsetDateTime(d OffsetDateTime) (OffsetDateTime, error) {
+	v, err := pd.addToBigDate(d.v, false)
+	if err != nil {
+		return OffsetDateTime{}, err
+	}
+	return OffsetDateTime{v: v, o: d.o}, nil
+}
+
+// AddToLocalDateTime returns the datetime d+pd, in the same manner as AddToOffsetDateTime.
+func (pd PeriodDuration) AddToLocalDateTime(d LocalDateTime) (LocalDateTime, error) {
+	v, err := pd.addToBigDate(d.v, false)
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+	return LocalDateTime{v: v}, nil
+}
+
+// AddToLocalDate returns the date d+pd, in the same manner as AddToOffsetDateTime,
+// except that any fraction of a day in the period is truncated.
+// An error is returned if the duration is not a whole number of days,
+// or if the resulting date would fall outside of the allowed range.
+func (pd PeriodDuration) AddToLocalDate(d LocalDate) (LocalDate, error) {
+	var rem big.Int
+	days, _ := new(big.Int).DivMod(&pd.Duration.v, bigIntDayExtent, &rem)
+	if rem.Sign() != 0 {
+		return 0, fmt.Errorf("duration is not a whole number of days")
+	} else if !days.IsInt64() || days.Int64() > maxJDN-minJDN || days.Int64() < minJDN-maxJDN {
+		return 0, errOutOfRange("date out of bounds")
+	}
+
+	years, months, _days, _, err := pd.Period.dateUnits()
+	if err != nil {
+		return 0, err
+	}
+
+	out, err := addDateToDate(int64(d), years, months, _days+int(days.Int64()))
+	if err != nil {
+		return 0, err
+	} else if out < minJDN || out > maxJDN {
+		return 0, errOutOfRange("date out of bounds")
+	}
+	return LocalDate(out), nil
+}
+
+// addToBigDate returns the datetime v plus pd, or v minus pd if neg is true.
+// The period is applied using AddDate before the duration is added.
+func (pd PeriodDuration) addToBigDate(v big.Int, neg bool) (big.Int, error) {
+	years, months, days, rem, err := pd.Period.dateUnits()
+	if err != nil {
+		return big.Int{}, err
+	}
+
+	d, err := pd.Duration.add(DurationOf(rem))
+	if err != nil {
+		return big.Int{}, err
+	}
+
+	if neg {
+		years, months, days = -years, -months, -days
+		if d, err = d.mul(-1); err != nil {
+			return big.Int{}, err
+		}
+	}
+
+	out, err := addDateToBigDate(v, years, months, days)
+	if err != nil {
+		return big.Int{}, err
+	}
+	return addDurationToBigDate(out, d)
+}
@@ -0,0 +1,210 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestPeriodDuration_Format(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		input     chrono.PeriodDuration
+		exclusive []chrono.Designator
+		expected  string
+	}{
+		{"zero", chrono.PeriodDuration{}, nil, "PT0S"},
+		{"period only", chrono.PeriodDuration{Period: chrono.Period{Years: 1, Days: 2}}, nil, "P1Y2D"},
+		{"duration only", chrono.PeriodDuration{Duration: chrono.DurationOf(90 * chrono.Minute)}, nil, "PT1H30M"},
+		{
+			name:     "both",
+			input:    chrono.PeriodDuration{Period: chrono.Period{Years: 1, Months: 2, Days: 10}, Duration: chrono.DurationOf(2*chrono.Hour + 30*chrono.Minute)},
+			expected: "P1Y2M10DT2H30M",
+		},
+		{
+			name:      "designators",
+			input:     chrono.PeriodDuration{Period: chrono.Period{Weeks: 3}},
+			exclusive: []chrono.Designator{chrono.Minutes},
+			expected:  "P3WT0M",
+		},
+		{
+			name:     "negative",
+			input:    chrono.PeriodDuration{Period: chrono.Period{Days: -1}, Duration: chrono.DurationOf(-chrono.Hour)},
+			expected: "-P1DT1H",
+		},
+		{"negative period only", chrono.PeriodDuration{Period: chrono.Period{Months: -6}}, nil, "-P6M"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.input.Format(tt.exclusive...); out != tt.expected {
+				t.Errorf("pd.Format() = %s, want %s", out, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPeriodDuration_Format_mixedSigns(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		input    chrono.PeriodDuration
+		expected string
+	}{
+		{"negative period", chrono.PeriodDuration{Period: chrono.Period{Years: -1}, Duration: chrono.DurationOf(chrono.Hour)}, "%!PeriodDuration(-P1Y, PT1H)"},
+		{"negative duration", chrono.PeriodDuration{Period: chrono.Period{Years: 1}, Duration: chrono.DurationOf(-chrono.Hour)}, "%!PeriodDuration(P1Y, -PT1H)"},
+		{"mixed period", chrono.PeriodDuration{Period: chrono.Period{Years: 1, Months: -1}}, "%!PeriodDuration(%!Period(P1Y-1M), PT0S)"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.input.CanFormat() {
+				t.Error("pd.CanFormat() = true, want false")
+			}
+
+			if _, err := tt.input.FormatChecked(); err == nil {
+				t.Error("expecting error but got nil")
+			}
+
+			if out := tt.input.String(); out != tt.expected {
+				t.Errorf("pd.String() = %s, want %s", out, tt.expected)
+			}
+
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Error("expecting panic")
+					}
+				}()
+				tt.input.Format()
+			}()
+		})
+	}
+}
+
+func TestPeriodDuration_Parse(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		expected chrono.PeriodDuration
+	}{
+		{"P1Y2M10DT2H30M", chrono.PeriodDuration{Period: chrono.Period{Years: 1, Months: 2, Days: 10}, Duration: chrono.DurationOf(2*chrono.Hour + 30*chrono.Minute)}},
+		{"P3W", chrono.PeriodDuration{Period: chrono.Period{Weeks: 3}}},
+		{"PT5S", chrono.PeriodDuration{Duration: chrono.DurationOf(5 * chrono.Second)}},
+		{"-P1DT1H", chrono.PeriodDuration{Period: chrono.Period{Days: -1}, Duration: chrono.DurationOf(-chrono.Hour)}},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			var pd chrono.PeriodDuration
+			if err := pd.Parse(tt.input); err != nil {
+				t.Errorf("failed to parse duration: %v", err)
+			} else if !pd.Period.Equal(tt.expected.Period) || pd.Duration.Compare(tt.expected.Duration) != 0 {
+				t.Errorf("parsed duration = %v, want %v", pd, tt.expected)
+			} else if out := pd.String(); out != tt.input {
+				t.Errorf("pd.String() = %s, want %s", out, tt.input)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var pd chrono.PeriodDuration
+		if err := pd.Parse("P"); err == nil {
+			t.Error("expecting error but got nil")
+		}
+	})
+}
+
+func TestPeriodDuration_arithmetic(t *testing.T) {
+	pd := chrono.PeriodDuration{Period: chrono.Period{Years: 1, Weeks: 2}, Duration: chrono.DurationOf(chrono.Hour)}
+	pd2 := chrono.PeriodDuration{Period: chrono.Period{Months: 3, Days: 1}, Duration: chrono.DurationOf(30 * chrono.Minute)}
+
+	for _, tt := range []struct {
+		name     string
+		result   chrono.PeriodDuration
+		expected string
+	}{
+		{"neg", pd.Neg(), "-P1Y2WT1H"},
+		{"add", pd.Add(pd2), "P1Y3M2W1DT1H30M"},
+		{"mul", pd.Mul(3), "P3Y6WT3H"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.result.String(); out != tt.expected {
+				t.Errorf("result = %s, want %s", out, tt.expected)
+			}
+		})
+	}
+
+	t.Run("overflow", func(t *testing.T) {
+		max := chrono.PeriodDuration{Duration: chrono.MaxDuration()}
+		if max.CanAdd(pd) {
+			t.Error("max.CanAdd(pd) = true, want false")
+		}
+
+		if max.CanMul(2) {
+			t.Error("max.CanMul(2) = true, want false")
+		}
+	})
+}
+
+func TestPeriodDuration_AddTo(t *testing.T) {
+	pd := chrono.PeriodDuration{Period: chrono.Period{Months: 1, Weeks: 1}, Duration: chrono.DurationOf(36 * chrono.Hour)}
+
+	t.Run("OffsetDateTime", func(t *testing.T) {
+		d := chrono.OffsetDateTimeOf(2020, chrono.January, 31, 12, 0, 0, 0, 2, 0)
+		expected := "2020-03-11 00:00:00+02:00"
+
+		if out, err := pd.AddToOffsetDateTime(d); err != nil {
+			t.Errorf("pd.AddToOffsetDateTime() error = %v", err)
+		} else if out.String() != expected {
+			t.Errorf("pd.AddToOffsetDateTime() = %s, want %s", out, expected)
+		}
+	})
+
+	t.Run("LocalDateTime", func(t *testing.T) {
+		d := chrono.LocalDateTimeOf(2020, chrono.January, 31, 12, 0, 0, 0)
+		expected := chrono.LocalDateTimeOf(2020, chrono.March, 11, 0, 0, 0, 0)
+
+		if out, err := pd.AddToLocalDateTime(d); err != nil {
+			t.Errorf("pd.AddToLocalDateTime() error = %v", err)
+		} else if out.Compare(expected) != 0 {
+			t.Errorf("pd.AddToLocalDateTime() = %s, want %s", out, expected)
+		}
+
+		if _, err := pd.AddToLocalDateTime(chrono.MaxLocalDateTime()); err == nil {
+			t.Error("expecting error but got nil")
+		}
+	})
+
+	t.Run("fraction", func(t *testing.T) {
+		d := chrono.LocalDateTimeOf(2020, chrono.January, 31, 12, 0, 0, 0)
+		for _, tt := range []struct {
+			period   string
+			expected chrono.LocalDateTime
+		}{
+			{"P1.5Y", chrono.LocalDateTimeOf(2021, chrono.July, 31, 12, 0, 0, 0)},
+			{"P1.5M", chrono.LocalDateTimeOf(2020, chrono.March, 2, 12, 0, 0, 0)},
+			{"P1.5W", chrono.LocalDateTimeOf(2020, chrono.February, 11, 0, 0, 0, 0)},
+			{"P1.25D", chrono.LocalDateTimeOf(2020, chrono.February, 1, 18, 0, 0, 0)},
+		} {
+			var pd chrono.PeriodDuration
+			if err := pd.Parse(tt.period); err != nil {
+				t.Fatalf("failed to parse period: %v", err)
+			}
+
+			if out, err := pd.AddToLocalDateTime(d); err != nil {
+				t.Errorf("pd.AddToLocalDateTime() error = %v", err)
+			} else if out.Compare(tt.expected) != 0 {
+				t.Errorf("%s: pd.AddToLocalDateTime() = %s, want %s", tt.period, out, tt.expected)
+			}
+		}
+	})
+
+	t.Run("LocalDate", func(t *testing.T) {
+		d := chrono.LocalDateOf(2020, chrono.January, 31)
+		days := chrono.PeriodDuration{Period: chrono.Period{Months: -1}, Duration: chrono.DurationOf(48 * chrono.Hour)}
+		expected := chrono.LocalDateOf(2020, chrono.January, 2)
+
+		if out, err := days.AddToLocalDate(d); err != nil {
+			t.Errorf("pd.AddToLocalDate() error = %v", err)
+		} else if out != expected {
+			t.Errorf("pd.AddToLocalDate() = %s, want %s", out, expected)
+		}
+
+		if _, err := pd.AddToLocalDate(d); err == nil {
+			t.Error("expecting error but got nil")
+		}
+	})
+}
@@ -1,6 +1,8 @@
 package chrono_test
 
 import (
+	"errors"
+	"math"
 	"strings"
 	"testing"
 
@@ -175,6 +177,264 @@ func TestPeriod_Parse(t *testing.T) {
 	}
 }
 
+func TestPeriod_sign(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		expected chrono.Period
+		output   string
+	}{
+		{"-P1Y2M", chrono.Period{Years: -1, Months: -2}, "-P1Y2M"},
+		{"+P1Y", chrono.Period{Years: 1}, "P1Y"},
+		{"-P3W1D", chrono.Period{Weeks: -3, Days: -1}, "-P3W1D"},
+		{"-P1Y0.5D", chrono.Period{Years: -1, Fraction: -500000000, FractionUnit: chrono.UnitDay}, "-P1Y0.5D"},
+		{"-P0.5M", chrono.Period{Fraction: -500000000, FractionUnit: chrono.UnitMonth}, "-P0.5M"},
+		{"-P0D", chrono.Period{}, "P0D"},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			var p chrono.Period
+			if err := p.Parse(tt.input); err != nil {
+				t.Fatalf("failed to parse period: %v", err)
+			} else if !p.Equal(tt.expected) {
+				t.Errorf("parsed period = %#v, want %#v", p, tt.expected)
+			}
+
+			if out := p.String(); out != tt.output {
+				t.Errorf("formatted period = %s, want %s", out, tt.output)
+			}
+
+			var p2 chrono.Period
+			if err := p2.Parse(p.Format()); err != nil {
+				t.Errorf("failed to parse formatted period: %v", err)
+			} else if !p2.Equal(p) {
+				t.Errorf("round-tripped period = %#v, want %#v", p2, p)
+			}
+		})
+	}
+
+	t.Run("mixed signs", func(t *testing.T) {
+		p := chrono.Period{Years: 1, Months: -2}
+		if p.CanFormat() {
+			t.Error("p.CanFormat() = true, want false")
+		}
+
+		if _, err := p.FormatChecked(); err == nil {
+			t.Error("expecting error but got nil")
+		}
+
+		if out, expected := p.String(), "%!Period(P1Y-2M)"; out != expected {
+			t.Errorf("p.String() = %s, want %s", out, expected)
+		}
+
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expecting panic")
+				}
+			}()
+			p.Format()
+		}()
+	})
+
+	t.Run("duration", func(t *testing.T) {
+		p, d, err := chrono.ParseDuration("-P1DT1H")
+		if err != nil {
+			t.Fatalf("failed to parse duration: %v", err)
+		}
+
+		if expected := (chrono.Period{Days: -1}); !p.Equal(expected) {
+			t.Errorf("parsed period = %#v, want %#v", p, expected)
+		}
+
+		if expected := chrono.DurationOf(-chrono.Hour); d.Compare(expected) != 0 {
+			t.Errorf("parsed duration = %v, want %v", d, expected)
+		}
+
+		if out := chrono.FormatDuration(p, d); out != "-P1DT1H" {
+			t.Errorf("chrono.FormatDuration() = %s, want -P1DT1H", out)
+		}
+	})
+}
+
+func TestPeriod_fraction(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		expected chrono.Period
+	}{
+		{"P16777217Y", chrono.Period{Years: 16777217}},
+		{"P2.5Y", chrono.Period{Years: 2, Fraction: 500000000, FractionUnit: chrono.UnitYear}},
+		{"P0.5M", chrono.Period{Fraction: 500000000, FractionUnit: chrono.UnitMonth}},
+		{"P1Y0.25D", chrono.Period{Years: 1, Fraction: 250000000, FractionUnit: chrono.UnitDay}},
+		{"P1Y2M3W4.123456789D", chrono.Period{Years: 1, Months: 2, Weeks: 3, Days: 4, Fraction: 123456789, FractionUnit: chrono.UnitDay}},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			var p chrono.Period
+			if err := p.Parse(tt.input); err != nil {
+				t.Fatalf("failed to parse period: %v", err)
+			} else if !p.Equal(tt.expected) {
+				t.Errorf("parsed period = %#v, want %#v", p, tt.expected)
+			}
+
+			if out := p.String(); out != tt.input {
+				t.Errorf("formatted period = %s, want %s", out, tt.input)
+			}
+		})
+	}
+
+	t.Run("invalid strings", func(t *testing.T) {
+		for _, tt := range []string{"P1.5Y2M", "P.Y", "P1.2.3D", "P99999999999999999999Y"} {
+			var p chrono.Period
+			if err := p.Parse(tt); err == nil {
+				t.Errorf("expecting error for %s but got nil", tt)
+			}
+		}
+	})
+
+	t.Run("arithmetic", func(t *testing.T) {
+		p := chrono.Period{Years: 1, Fraction: 500000000, FractionUnit: chrono.UnitYear}
+
+		if out, expected := p.Add(p), (chrono.Period{Years: 3}); !out.Equal(expected) {
+			t.Errorf("p.Add(p) = %s, want %s", out, expected)
+		}
+
+		if out, expected := p.Sub(chrono.Period{Years: 1, Fraction: 700000000, FractionUnit: chrono.UnitYear}), (chrono.Period{Fraction: -200000000, FractionUnit: chrono.UnitYear}); !out.Equal(expected) {
+			t.Errorf("p.Sub() = %#v, want %#v", out, expected)
+		}
+
+		if out, expected := p.Mul(3), (chrono.Period{Years: 4, Fraction: 500000000, FractionUnit: chrono.UnitYear}); !out.Equal(expected) {
+			t.Errorf("p.Mul(3) = %s, want %s", out, expected)
+		}
+
+		if p.CanAdd(chrono.Period{Fraction: 500000000, FractionUnit: chrono.UnitDay}) {
+			t.Error("p.CanAdd() = true, want false")
+		}
+	})
+
+	t.Run("fraction of larger unit", func(t *testing.T) {
+		half := chrono.Period{Fraction: 500000000, FractionUnit: chrono.UnitYear}
+
+		if half.CanAdd(chrono.Period{Months: 1}) {
+			t.Error("half.CanAdd(P1M) = true, want false")
+		}
+
+		if out, expected := half.Add(chrono.Period{Years: 1}), (chrono.Period{Years: 1, Fraction: 500000000, FractionUnit: chrono.UnitYear}); !out.Equal(expected) {
+			t.Errorf("half.Add(P1Y) = %s, want %s", out, expected)
+		}
+
+		invalid := chrono.Period{Months: 1, Fraction: 500000000, FractionUnit: chrono.UnitYear}
+		if invalid.CanMul(2) {
+			t.Error("invalid.CanMul(2) = true, want false")
+		}
+
+		if invalid.CanFormat() {
+			t.Error("invalid.CanFormat() = true, want false")
+		}
+
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expecting panic from Normalized")
+				}
+			}()
+			invalid.Normalized()
+		}()
+
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expecting panic from FormatDuration")
+				}
+			}()
+			chrono.FormatDuration(invalid, chrono.DurationOf(chrono.Hour))
+		}()
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		if (chrono.Period{Years: math.MaxInt/2 + 1}).CanMul(2) {
+			t.Error("CanMul(2) = true, want false")
+		}
+
+		if _, err := (chrono.Period{Days: math.MaxInt}).AddChecked(chrono.Period{Days: 1}); !errors.Is(err, chrono.ErrOverflow) {
+			t.Errorf("AddChecked() = %v, want %v", err, chrono.ErrOverflow)
+		}
+
+		p := chrono.Period{Days: 1, Fraction: 500000000, FractionUnit: chrono.UnitDay}
+		if out, expected := p.Mul(-3), (chrono.Period{Days: -4, Fraction: -500000000, FractionUnit: chrono.UnitDay}); !out.Equal(expected) {
+			t.Errorf("p.Mul(-3) = %s, want %s", out, expected)
+		}
+
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expecting panic from Normalized")
+				}
+			}()
+			chrono.Period{Years: math.MaxInt / 2}.Normalized()
+		}()
+
+		pd := chrono.PeriodDuration{Period: chrono.Period{Weeks: math.MaxInt / 2}}
+		if _, err := pd.AddToLocalDateTime(chrono.LocalDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0)); !errors.Is(err, chrono.ErrOverflow) {
+			t.Errorf("pd.AddToLocalDateTime() = %v, want %v", err, chrono.ErrOverflow)
+		}
+
+		if out, expected := (chrono.Period{Fraction: 999999999, FractionUnit: chrono.UnitYear}).Normalized(), (chrono.Period{Months: 11, Fraction: 999999988, FractionUnit: chrono.UnitMonth}); !out.Equal(expected) {
+			t.Errorf("Normalized() = %s, want %s", out, expected)
+		}
+	})
+}
+
+func TestPeriod_arithmetic(t *testing.T) {
+	p := chrono.Period{Years: 1, Months: 6, Weeks: 1, Days: 2}
+
+	for _, tt := range []struct {
+		name     string
+		result   chrono.Period
+		expected chrono.Period
+	}{
+		{"add", p.Add(chrono.Period{Months: 8, Days: -1}), chrono.Period{Years: 1, Months: 14, Weeks: 1, Days: 1}},
+		{"sub", p.Sub(chrono.Period{Years: 2}), chrono.Period{Years: -1, Months: 6, Weeks: 1, Days: 2}},
+		{"neg", p.Neg(), chrono.Period{Years: -1, Months: -6, Weeks: -1, Days: -2}},
+		{"mul", p.Mul(3), chrono.Period{Years: 3, Months: 18, Weeks: 3, Days: 6}},
+		{"normalized", chrono.Period{Years: 1, Months: 14, Weeks: 2, Days: 1}.Normalized(), chrono.Period{Years: 2, Months: 2, Days: 15}},
+		{"normalized mixed signs", chrono.Period{Years: 1, Months: -2}.Normalized(), chrono.Period{Months: 10}},
+		{"normalized negative", chrono.Period{Months: -14}.Normalized(), chrono.Period{Years: -1, Months: -2}},
+		{"normalized fractional year", chrono.Period{Years: 2, Fraction: 550000000, FractionUnit: chrono.UnitYear}.Normalized(), chrono.Period{Years: 2, Months: 6, Fraction: 600000000, FractionUnit: chrono.UnitMonth}},
+		{"normalized fractional week", chrono.Period{Weeks: 1, Fraction: 500000000, FractionUnit: chrono.UnitWeek}.Normalized(), chrono.Period{Days: 10, Fraction: 500000000, FractionUnit: chrono.UnitDay}},
+		{"normalized weeks", chrono.Period{Weeks: 1, Days: 10}.NormalizedWeeks(), chrono.Period{Weeks: 2, Days: 3}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.result.Equal(tt.expected) {
+				t.Errorf("result = %s, want %s", tt.result, tt.expected)
+			}
+		})
+	}
+
+	if !(chrono.Period{}).IsZero() || p.IsZero() {
+		t.Error("unexpected IsZero result")
+	}
+
+	if p.IsNegative() || !(chrono.Period{Years: 1, Days: -1}).IsNegative() {
+		t.Error("unexpected IsNegative result")
+	}
+
+	if neg := p.Neg(); neg.String() == p.String() {
+		t.Errorf("p.Neg().String() = %s, want it to differ from p.String()", neg)
+	} else if out, expected := neg.String(), "-P1Y6M1W2D"; out != expected {
+		t.Errorf("p.Neg().String() = %s, want %s", out, expected)
+	}
+
+	if out, expected := (chrono.Period{}).Sub(p).String(), "-P1Y6M1W2D"; out != expected {
+		t.Errorf("Period{}.Sub(p).String() = %s, want %s", out, expected)
+	}
+
+	if months := p.TotalMonths(); months != 18 {
+		t.Errorf("p.TotalMonths() = %v, want 18", months)
+	}
+
+	if days := p.TotalDays(); days != 9 {
+		t.Errorf("p.TotalDays() = %v, want 9", days)
+	}
+}
+
 func TestParseDuration(t *testing.T) {
 	for _, tt := range []struct {
 		name     string
@@ -185,7 +445,7 @@ func TestParseDuration(t *testing.T) {
 		{
 			name:     "period only",
 			input:    "P2.5Y",
-			period:   chrono.Period{Years: 2.5},
+			period:   chrono.Period{Years: 2, Fraction: 500000000, FractionUnit: chrono.UnitYear},
 			duration: chrono.Duration{},
 		},
 		{
@@ -197,7 +457,7 @@ func TestParseDuration(t *testing.T) {
 		{
 			name:     "both period and duration",
 			input:    "P2.5YT6.5H",
-			period:   chrono.Period{Years: 2.5},
+			period:   chrono.Period{Years: 2, Fraction: 500000000, FractionUnit: chrono.UnitYear},
 			duration: chrono.DurationOf((6 * chrono.Hour) + (30 * chrono.Minute)),
 		},
 	} {
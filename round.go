@@ -0,0 +1,178 @@
+package chrono
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Anchor specifies the point from which steps are counted when truncating or rounding to an Extent.
+type Anchor int
+
+// The anchors from which steps are counted.
+const (
+	// AnchorMidnight counts steps from midnight at the start of each day. If the step does not evenly divide a day,
+	// the last step of each day is shortened, such that each day still starts on a boundary.
+	AnchorMidnight Anchor = iota + 1
+	// AnchorUnixEpoch counts steps from the Unix epoch, 1st January 1970 00:00:00 UTC.
+	// For local date-times, the epoch is taken to be 1st January 1970 00:00:00 in the same local time.
+	AnchorUnixEpoch
+)
+
+func (a Anchor) String() string {
+	switch a {
+	case AnchorMidnight:
+		return "Midnight"
+	case AnchorUnixEpoch:
+		return "UnixEpoch"
+	}
+	return fmt.Sprintf("%%!Anchor(%d)", a)
+}
+
+// roundMode specifies the direction in which a value is rounded to a boundary.
+type roundMode int
+
+const (
+	roundFloor roundMode = iota
+	roundNearest
+	roundCeil
+)
+
+// roundTime rounds the time of day t to a multiple of the unit u, which must be a unit of time or UnitDay.
+func roundTime(t int64, u Unit, mode roundMode) (int64, error) {
+	step, ok := u.extent()
+	if !ok {
+		return 0, errUnsupportedUnit(u)
+	}
+	return roundTimeToStep(t, step, mode)
+}
+
+// roundTimeToStep rounds the time of day t to a multiple of step, counted from midnight.
+func roundTimeToStep(t int64, step Extent, mode roundMode) (int64, error) {
+	if step <= 0 {
+		return 0, fmt.Errorf("step must be positive")
+	}
+
+	out := chooseBoundary(t-t%int64(step), t%int64(step), int64(step), mode)
+	if out > maxTime {
+		return 0, errOutOfRange("time out of range")
+	}
+	return out, nil
+}
+
+// roundBigDate rounds the datetime v to the start of the unit u in which it occurs, or the start of the following unit.
+func roundBigDate(v big.Int, u Unit, mode roundMode) (big.Int, error) {
+	if step, ok := u.extent(); ok {
+		return roundBigDateToStep(v, step, big.Int{}, false, mode)
+	}
+
+	date, _ := splitDateAndTime(v)
+	floorDate, err := truncateDateToUnit(date, u)
+	if err != nil {
+		return big.Int{}, err
+	}
+
+	floor := makeDateTime(floorDate, 0)
+	if mode == roundFloor || floor.Cmp(&v) == 0 {
+		return floor, nil
+	}
+
+	ceilDate, err := addUnitsToDate(floorDate, u, 1)
+	if err != nil {
+		return big.Int{}, err
+	}
+	ceil := makeDateTime(ceilDate, 0)
+
+	if mode == roundNearest {
+		var below, above big.Int
+		below.Sub(&v, &floor)
+		above.Sub(&ceil, &v)
+		if below.Cmp(&above) < 0 {
+			return floor, nil
+		}
+	}
+	return checkBigDate(ceil)
+}
+
+// roundBigDateToStep rounds the datetime v to a multiple of step, counted from anchor.
+// If midnight is true, the anchor is instead midnight at the start of the day on which v occurs.
+func roundBigDateToStep(v big.Int, step Extent, anchor big.Int, midnight bool, mode roundMode) (big.Int, error) {
+	if step <= 0 {
+		return big.Int{}, fmt.Errorf("step must be positive")
+	}
+
+	if midnight {
+		date, _ := splitDateAndTime(v)
+		anchor = makeDateTime(date, 0)
+	}
+
+	var rem big.Int
+	rem.Sub(&v, &anchor)
+	rem.Mod(&rem, big.NewInt(int64(step)))
+
+	var floor big.Int
+	floor.Sub(&v, &rem)
+	if mode == roundFloor || rem.Sign() == 0 {
+		return floor, nil
+	}
+
+	var ceil big.Int
+	ceil.Add(&floor, big.NewInt(int64(step)))
+	if midnight {
+		next := new(big.Int).Add(&anchor, bigIntDayExtent)
+		if ceil.Cmp(next) > 0 {
+			ceil.Set(next)
+		}
+	}
+
+	if mode == roundNearest {
+		var above big.Int
+		above.Sub(&ceil, &v)
+		if rem.Cmp(&above) < 0 {
+			return floor, nil
+		}
+	}
+	return checkBigDate(ceil)
+}
+
+// chooseBoundary returns floor or floor+step, according to mode, given the remainder rem of the value from floor.
+func chooseBoundary(floor, rem, step int64, mode roundMode) int64 {
+	switch {
+	case rem == 0 || mode == roundFloor:
+		return floor
+	case mode == roundNearest && rem < step-rem:
+		return floor
+	}
+	return floor + step
+}
+
+func checkBigDate(v big.Int) (big.Int, error) {
+	if v.Cmp(&minLocalDateTime.v) == -1 || v.Cmp(&maxLocalDateTime.v) == 1 {
+		return big.Int{}, errOutOfRange("datetime out of range")
+	}
+	return v, nil
+}
+
+func mustRoundTime(v int64, err error) int64 {
+	if err != nil {
+		panic(err.Error())
+	}
+	return v
+}
+
+func mustRoundBigDate(v big.Int, err error) big.Int {
+	if err != nil {
+		panic(err.Error())
+	}
+	return v
+}
+
+// mustMidnight reports whether a is AnchorMidnight, and panics if a is not valid.
+func mustMidnight(a Anchor) bool {
+	switch a {
+	case AnchorMidnight:
+		return true
+	case AnchorUnixEpoch:
+		return false
+	}
+	panic(fmt.Sprintf("invalid anchor %s", a))
+}
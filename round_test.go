@@ -0,0 +1,146 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestLocalDateTime_Truncate(t *testing.T) {
+	dt := chrono.LocalDateTimeOf(2021, chrono.May, 19, 13, 47, 31, 500000000) // Wednesday.
+
+	for _, tt := range []struct {
+		name     string
+		f        func(chrono.Unit) chrono.LocalDateTime
+		unit     chrono.Unit
+		expected chrono.LocalDateTime
+	}{
+		{"truncate second", dt.Truncate, chrono.UnitSecond, chrono.LocalDateTimeOf(2021, chrono.May, 19, 13, 47, 31, 0)},
+		{"round second", dt.Round, chrono.UnitSecond, chrono.LocalDateTimeOf(2021, chrono.May, 19, 13, 47, 32, 0)},
+		{"ceil minute", dt.Ceil, chrono.UnitMinute, chrono.LocalDateTimeOf(2021, chrono.May, 19, 13, 48, 0, 0)},
+		{"truncate hour", dt.Truncate, chrono.UnitHour, chrono.LocalDateTimeOf(2021, chrono.May, 19, 13, 0, 0, 0)},
+		{"round hour", dt.Round, chrono.UnitHour, chrono.LocalDateTimeOf(2021, chrono.May, 19, 14, 0, 0, 0)},
+		{"truncate day", dt.Truncate, chrono.UnitDay, chrono.LocalDateTimeOf(2021, chrono.May, 19, 0, 0, 0, 0)},
+		{"round day", dt.Round, chrono.UnitDay, chrono.LocalDateTimeOf(2021, chrono.May, 20, 0, 0, 0, 0)},
+		{"truncate week", dt.Truncate, chrono.UnitWeek, chrono.LocalDateTimeOf(2021, chrono.May, 17, 0, 0, 0, 0)},
+		{"ceil week", dt.Ceil, chrono.UnitWeek, chrono.LocalDateTimeOf(2021, chrono.May, 24, 0, 0, 0, 0)},
+		{"truncate month", dt.Truncate, chrono.UnitMonth, chrono.LocalDateTimeOf(2021, chrono.May, 1, 0, 0, 0, 0)},
+		{"round month", dt.Round, chrono.UnitMonth, chrono.LocalDateTimeOf(2021, chrono.June, 1, 0, 0, 0, 0)},
+		{"truncate quarter", dt.Truncate, chrono.UnitQuarter, chrono.LocalDateTimeOf(2021, chrono.April, 1, 0, 0, 0, 0)},
+		{"ceil quarter", dt.Ceil, chrono.UnitQuarter, chrono.LocalDateTimeOf(2021, chrono.July, 1, 0, 0, 0, 0)},
+		{"round year", dt.Round, chrono.UnitYear, chrono.LocalDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0)},
+		{"ceil year", dt.Ceil, chrono.UnitYear, chrono.LocalDateTimeOf(2022, chrono.January, 1, 0, 0, 0, 0)},
+		{"ceil boundary", chrono.LocalDateTimeOf(2021, chrono.May, 1, 0, 0, 0, 0).Ceil, chrono.UnitMonth, chrono.LocalDateTimeOf(2021, chrono.May, 1, 0, 0, 0, 0)},
+		{"before epoch", chrono.LocalDateTimeOf(1969, chrono.December, 31, 23, 59, 59, 500000000).Truncate, chrono.UnitSecond, chrono.LocalDateTimeOf(1969, chrono.December, 31, 23, 59, 59, 0)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.f(tt.unit); out.Compare(tt.expected) != 0 {
+				t.Errorf("%s(%s) = %s, want %s", tt.name, tt.unit, out, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocalDateTime_TruncateExtent(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		out      func() chrono.LocalDateTime
+		expected chrono.LocalDateTime
+	}{
+		{"truncate", func() chrono.LocalDateTime {
+			return chrono.LocalDateTimeOf(2021, chrono.May, 19, 13, 47, 0, 0).TruncateExtent(15*chrono.Minute, chrono.AnchorMidnight)
+		}, chrono.LocalDateTimeOf(2021, chrono.May, 19, 13, 45, 0, 0)},
+		{"ceil", func() chrono.LocalDateTime {
+			return chrono.LocalDateTimeOf(2021, chrono.May, 19, 13, 47, 0, 0).CeilExtent(15*chrono.Minute, chrono.AnchorMidnight)
+		}, chrono.LocalDateTimeOf(2021, chrono.May, 19, 14, 0, 0, 0)},
+		{"round shortened step", func() chrono.LocalDateTime {
+			return chrono.LocalDateTimeOf(2021, chrono.May, 19, 23, 0, 0, 0).RoundExtent(7*chrono.Hour, chrono.AnchorMidnight)
+		}, chrono.LocalDateTimeOf(2021, chrono.May, 20, 0, 0, 0, 0)},
+		{"ceil epoch", func() chrono.LocalDateTime {
+			return chrono.LocalDateTimeOf(1970, chrono.January, 1, 23, 0, 0, 0).CeilExtent(7*chrono.Hour, chrono.AnchorUnixEpoch)
+		}, chrono.LocalDateTimeOf(1970, chrono.January, 2, 4, 0, 0, 0)},
+		{"truncate epoch", func() chrono.LocalDateTime {
+			return chrono.LocalDateTimeOf(1970, chrono.January, 2, 3, 0, 0, 0).TruncateExtent(7*chrono.Hour, chrono.AnchorUnixEpoch)
+		}, chrono.LocalDateTimeOf(1970, chrono.January, 1, 21, 0, 0, 0)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.out(); out.Compare(tt.expected) != 0 {
+				t.Errorf("out = %s, want %s", out, tt.expected)
+			}
+		})
+	}
+
+	t.Run("invalid step", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expecting panic")
+			}
+		}()
+		chrono.LocalDateTimeOf(2021, chrono.May, 19, 0, 0, 0, 0).TruncateExtent(0, chrono.AnchorMidnight)
+	})
+}
+
+func TestOffsetDateTime_Truncate(t *testing.T) {
+	dt := chrono.OffsetDateTimeOf(2021, chrono.May, 19, 13, 47, 31, 0, 5, 30)
+
+	for _, tt := range []struct {
+		name     string
+		out      chrono.OffsetDateTime
+		expected string
+	}{
+		{"truncate hour", dt.Truncate(chrono.UnitHour), "2021-05-19 13:00:00+05:30"},
+		{"truncate day", dt.Truncate(chrono.UnitDay), "2021-05-19 00:00:00+05:30"},
+		{"ceil month", dt.Ceil(chrono.UnitMonth), "2021-06-01 00:00:00+05:30"},
+		{"midnight anchor", dt.TruncateExtent(chrono.Hour, chrono.AnchorMidnight), "2021-05-19 13:00:00+05:30"},
+		{"epoch anchor", dt.TruncateExtent(chrono.Hour, chrono.AnchorUnixEpoch), "2021-05-19 13:30:00+05:30"},
+		{"epoch anchor round", dt.RoundExtent(chrono.Hour, chrono.AnchorUnixEpoch), "2021-05-19 13:30:00+05:30"},
+		{"epoch anchor ceil", dt.CeilExtent(chrono.Hour, chrono.AnchorUnixEpoch), "2021-05-19 14:30:00+05:30"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if str := tt.out.String(); str != tt.expected {
+				t.Errorf("out = %s, want %s", str, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocalTime_Truncate(t *testing.T) {
+	tm := chrono.LocalTimeOf(13, 47, 31, 500000000)
+
+	for _, tt := range []struct {
+		name     string
+		out      chrono.LocalTime
+		expected chrono.LocalTime
+	}{
+		{"truncate minute", tm.Truncate(chrono.UnitMinute), chrono.LocalTimeOf(13, 47, 0, 0)},
+		{"round second", tm.Round(chrono.UnitSecond), chrono.LocalTimeOf(13, 47, 32, 0)},
+		{"ceil hour", tm.Ceil(chrono.UnitHour), chrono.LocalTimeOf(14, 0, 0, 0)},
+		{"truncate day", tm.Truncate(chrono.UnitDay), chrono.LocalTimeOf(0, 0, 0, 0)},
+		{"truncate extent", tm.TruncateExtent(25 * chrono.Minute), chrono.LocalTimeOf(13, 45, 0, 0)},
+		{"round extent", tm.RoundExtent(25 * chrono.Minute), chrono.LocalTimeOf(13, 45, 0, 0)},
+		{"ceil extent", tm.CeilExtent(25 * chrono.Minute), chrono.LocalTimeOf(14, 10, 0, 0)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.out.Compare(tt.expected) != 0 {
+				t.Errorf("out = %s, want %s", tt.out, tt.expected)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name string
+		f    func()
+	}{
+		{"unsupported unit", func() { tm.Truncate(chrono.UnitMonth) }},
+		{"out of range", func() { chrono.LocalTimeOf(99, 30, 0, 0).Ceil(chrono.UnitHour) }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expecting panic")
+				}
+			}()
+			tt.f()
+		})
+	}
+}
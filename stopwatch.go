@@ -0,0 +1,199 @@
+package chrono
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Stopwatch measures elapsed time using a monotonic clock, optionally divided into named laps.
+// A Stopwatch can be paused by calling Stop, and resumed by calling Start; time spent paused is not counted.
+//
+// The zero value is a stopped Stopwatch that uses SystemClock. A Stopwatch is safe for concurrent use.
+type Stopwatch struct {
+	mu      sync.Mutex
+	c       Clock
+	running bool
+	started Instant
+	elapsed Duration
+	lap     Duration
+	laps    []Lap
+}
+
+// Lap is a named interval recorded by a Stopwatch.
+type Lap struct {
+	Name     string
+	Duration Duration
+}
+
+// NewStopwatch returns a Stopwatch that has been started using SystemClock.
+func NewStopwatch() *Stopwatch {
+	return NewStopwatchOn(SystemClock())
+}
+
+// NewStopwatchOn returns a Stopwatch that has been started using the monotonic clock provided by c.
+func NewStopwatchOn(c Clock) *Stopwatch {
+	s := &Stopwatch{c: c}
+	s.Start()
+	return s
+}
+
+func (s *Stopwatch) clock() Clock {
+	if s.c == nil {
+		s.c = SystemClock()
+	}
+	return s.c
+}
+
+// Start starts the stopwatch, or resumes it if it was stopped. It has no effect if the stopwatch is already running.
+func (s *Stopwatch) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		s.running = true
+		s.started = s.clock().Now()
+	}
+}
+
+// Stop pauses the stopwatch. It has no effect if the stopwatch is not running.
+func (s *Stopwatch) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.elapsed = s.elapsedLocked()
+		s.running = false
+	}
+}
+
+// Reset stops the stopwatch, and discards its elapsed time and laps.
+func (s *Stopwatch) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.running = false
+	s.elapsed, s.lap = Duration{}, Duration{}
+	s.laps = nil
+}
+
+// Running reports whether the stopwatch is running.
+func (s *Stopwatch) Running() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+// Elapsed returns the total time for which the stopwatch has been running.
+func (s *Stopwatch) Elapsed() Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.elapsedLocked()
+}
+
+func (s *Stopwatch) elapsedLocked() Duration {
+	if !s.running {
+		return s.elapsed
+	}
+	return s.elapsed.Add(s.clock().Now().Sub(s.started))
+}
+
+// Lap records a lap with the specified name, which ends now and begins at the end of the previous lap,
+// or when the stopwatch was first started. The duration of the lap is returned.
+func (s *Stopwatch) Lap(name string) Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elapsed := s.elapsedLocked()
+	lap := Lap{Name: name, Duration: elapsed.Sub(s.lap)}
+	s.laps = append(s.laps, lap)
+	s.lap = elapsed
+	return lap.Duration
+}
+
+// Laps returns the laps that have been recorded, in the order in which they were recorded.
+func (s *Stopwatch) Laps() []Lap {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Lap(nil), s.laps...)
+}
+
+// Summary returns statistics about the laps that have been recorded.
+func (s *Stopwatch) Summary() StopwatchSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := StopwatchSummary{
+		Laps:    append([]Lap(nil), s.laps...),
+		Elapsed: s.elapsedLocked(),
+		sorted:  make([]Duration, len(s.laps)),
+	}
+
+	for i, lap := range s.laps {
+		out.sorted[i] = lap.Duration
+		out.Total = out.Total.Add(lap.Duration)
+	}
+
+	sort.Slice(out.sorted, func(i, j int) bool {
+		return out.sorted[i].Compare(out.sorted[j]) < 0
+	})
+
+	if n := len(out.sorted); n != 0 {
+		out.Min, out.Max = out.sorted[0], out.sorted[n-1]
+		out.Mean = out.Total.Div(int64(n))
+	}
+	return out
+}
+
+// StopwatchSummary contains statistics about the laps recorded by a Stopwatch.
+// If no laps were recorded, all of the statistics are zero.
+type StopwatchSummary struct {
+	Laps []Lap
+	// Elapsed is the total time for which the stopwatch had been running, including time since the last lap.
+	Elapsed Duration
+	// Total is the sum of the durations of the laps.
+	Total Duration
+	// Min, Max and Mean are the shortest, longest and mean durations of the laps.
+	Min, Max, Mean Duration
+
+	sorted []Duration
+}
+
+// Percentile returns the duration of the lap at the pth percentile, using the nearest-rank method,
+// such that p percent of the laps are no longer than it. It panics if p is not in the range [0, 100].
+func (s StopwatchSummary) Percentile(p float64) Duration {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		panic("percentile out of range")
+	} else if len(s.sorted) == 0 {
+		return Duration{}
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(len(s.sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return s.sorted[rank-1]
+}
+
+// String returns a table containing the duration of each lap, followed by the statistics of the laps,
+// with each duration formatted by Duration.Format.
+func (s StopwatchSummary) String() string {
+	var width int
+	for _, lap := range s.Laps {
+		if len(lap.Name) > width {
+			width = len(lap.Name)
+		}
+	}
+
+	var out strings.Builder
+	for _, lap := range s.Laps {
+		fmt.Fprintf(&out, "%-*s  %s\n", width, lap.Name, lap.Duration.Format())
+	}
+
+	fmt.Fprintf(&out, "elapsed %s, total %s, min %s, max %s, mean %s, p50 %s, p90 %s, p99 %s",
+		s.Elapsed.Format(), s.Total.Format(), s.Min.Format(), s.Max.Format(), s.Mean.Format(),
+		s.Percentile(50).Format(), s.Percentile(90).Format(), s.Percentile(99).Format())
+	return out.String()
+}
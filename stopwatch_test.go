@@ -0,0 +1,89 @@
+package chrono_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/go-chrono/chrono"
+	chronotest "github.com/go-chrono/chrono/test"
+)
+
+func TestStopwatch(t *testing.T) {
+	c := chronotest.NewClock(chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 0, 0))
+	s := chrono.NewStopwatchOn(c)
+
+	c.Advance(2 * chrono.Second)
+	if d := s.Lap("setup"); d.Compare(chrono.DurationOf(2*chrono.Second)) != 0 {
+		t.Errorf("s.Lap() = %s, want PT2S", d)
+	}
+
+	c.Advance(chrono.Minute)
+	s.Stop()
+	c.Advance(chrono.Hour)
+	s.Start()
+	c.Advance(chrono.Minute)
+	s.Lap("build")
+
+	c.Advance(4 * chrono.Second)
+	s.Lap("test")
+	c.Advance(chrono.Second)
+
+	summary := s.Summary()
+	for _, tt := range []struct {
+		name     string
+		value    chrono.Duration
+		expected chrono.Extent
+	}{
+		{"elapsed", summary.Elapsed, 2*chrono.Minute + 7*chrono.Second},
+		{"total", summary.Total, 2*chrono.Minute + 6*chrono.Second},
+		{"min", summary.Min, 2 * chrono.Second},
+		{"max", summary.Max, 2 * chrono.Minute},
+		{"mean", summary.Mean, 42 * chrono.Second},
+		{"p50", summary.Percentile(50), 4 * chrono.Second},
+		{"p0", summary.Percentile(0), 2 * chrono.Second},
+		{"p100", summary.Percentile(100), 2 * chrono.Minute},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.value.Compare(chrono.DurationOf(tt.expected)) != 0 {
+				t.Errorf("%s = %s, want %s", tt.name, tt.value, chrono.DurationOf(tt.expected))
+			}
+		})
+	}
+
+	expected := "setup  PT2S\n" +
+		"build  PT2M\n" +
+		"test   PT4S\n" +
+		"elapsed PT2M7S, total PT2M6S, min PT2S, max PT2M, mean PT42S, p50 PT4S, p90 PT2M, p99 PT2M"
+	if str := summary.String(); str != expected {
+		t.Errorf("summary.String() = %q, want %q", str, expected)
+	}
+
+	s.Reset()
+	if s.Running() || len(s.Laps()) != 0 || s.Elapsed().Compare(chrono.Duration{}) != 0 {
+		t.Error("stopwatch not reset")
+	}
+
+	if summary := s.Summary(); summary.Percentile(50).Compare(chrono.Duration{}) != 0 {
+		t.Errorf("empty summary p50 = %s", summary.Percentile(50))
+	}
+}
+
+func TestStopwatch_concurrent(t *testing.T) {
+	var s chrono.Stopwatch
+	s.Start()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.Lap("lap")
+			s.Summary()
+		}()
+	}
+	wg.Wait()
+
+	if n := len(s.Laps()); n != 10 {
+		t.Errorf("len(s.Laps()) = %d, want 10", n)
+	}
+}
@@ -0,0 +1,147 @@
+package chronotest
+
+import (
+	"sync"
+
+	"github.com/go-chrono/chrono"
+)
+
+// Clock is a fake chrono.Clock, whose readings only change when it is explicitly advanced or set.
+// Timers that are created using AfterFunc fire when the clock is advanced past their deadline,
+// which allows code that waits for timeouts and schedules to be tested deterministically.
+//
+// Unlike SystemClock, which calls the function passed to AfterFunc in its own goroutine,
+// Clock calls it synchronously from Advance, on the goroutine that called Advance.
+// The function must therefore not call Advance itself, nor wait on the goroutine that called Advance,
+// or Advance will deadlock. Code under test that blocks until a timer fires should run in its own goroutine,
+// using BlockUntil to wait until it has created its timer.
+//
+// A Clock is safe for concurrent use. It must be created using NewClock.
+type Clock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	advance sync.Mutex
+	mono    int64
+	wall    chrono.OffsetDateTime
+	timers  []*timer
+}
+
+type timer struct {
+	deadline int64
+	f        func()
+}
+
+// NewClock returns a Clock whose wall clock reads wall.
+// Its monotonic clock starts at an arbitrary reading.
+func NewClock(wall chrono.OffsetDateTime) *Clock {
+	c := &Clock{mono: 1, wall: wall}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the current reading of the monotonic clock.
+func (c *Clock) Now() chrono.Instant {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return InstantOf(c.mono)
+}
+
+// NowUTC returns the current reading of the wall clock in UTC.
+func (c *Clock) NowUTC() chrono.OffsetDateTime {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.wall.UTC()
+}
+
+// AfterFunc creates a timer that calls f synchronously from Advance, once the clock has been advanced by at least d.
+// If d is not positive, f is instead called in its own goroutine immediately.
+// The returned function stops the timer, and reports false if the timer had already fired or been stopped.
+func (c *Clock) AfterFunc(d chrono.Extent, f func()) (stop func() bool) {
+	if d <= 0 {
+		go f()
+		return func() bool { return false }
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := &timer{deadline: c.mono + int64(d), f: f}
+
+	// Timers are kept in order of their deadlines, and then in the order in which they were created.
+	i := len(c.timers)
+	for i > 0 && c.timers[i-1].deadline > t.deadline {
+		i--
+	}
+	c.timers = append(c.timers, nil)
+	copy(c.timers[i+1:], c.timers[i:])
+	c.timers[i] = t
+
+	c.cond.Broadcast()
+	return func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.remove(t)
+	}
+}
+
+func (c *Clock) remove(t *timer) bool {
+	for i, t2 := range c.timers {
+		if t2 == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Advance moves both the monotonic and wall clocks forward by d, firing any timers whose deadlines are reached.
+// Timers are fired in order of their deadlines, and for each one, the clock is first moved to the timer's deadline,
+// then the timer's function is called on the calling goroutine before Advance continues. Timers created by these functions
+// are also fired if their deadlines are reached. This function panics if d is negative, and must not be called by a timer's function.
+func (c *Clock) Advance(d chrono.Extent) {
+	if d < 0 {
+		panic("cannot advance by a negative extent")
+	}
+
+	c.advance.Lock()
+	defer c.advance.Unlock()
+
+	c.mu.Lock()
+	target := c.mono + int64(d)
+	for len(c.timers) != 0 && c.timers[0].deadline <= target {
+		t := c.timers[0]
+		c.timers = c.timers[1:]
+		c.moveTo(t.deadline)
+
+		c.mu.Unlock()
+		t.f()
+		c.mu.Lock()
+	}
+	c.moveTo(target)
+	c.mu.Unlock()
+}
+
+func (c *Clock) moveTo(mono int64) {
+	c.wall = c.wall.Add(chrono.DurationOf(chrono.Extent(mono - c.mono)))
+	c.mono = mono
+}
+
+// Set changes the reading of the wall clock to wall, in the same manner as an adjustment to the system's clock.
+// The monotonic clock is not affected, and so no timers are fired.
+func (c *Clock) Set(wall chrono.OffsetDateTime) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.wall = wall
+}
+
+// BlockUntil blocks until at least n timers are waiting to be fired.
+// It is typically used to wait until the code under test has started waiting, before calling Advance.
+func (c *Clock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.timers) < n {
+		c.cond.Wait()
+	}
+}
+
+var _ chrono.Clock = (*Clock)(nil)
@@ -0,0 +1,84 @@
+package chronotest_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+	chronotest "github.com/go-chrono/chrono/test"
+)
+
+func TestClock(t *testing.T) {
+	start := chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 2, 0)
+
+	t.Run("readings", func(t *testing.T) {
+		c := chronotest.NewClock(start)
+		mono := c.Now()
+
+		c.Advance(90 * chrono.Minute)
+		if elapsed := mono.Until(c.Now()); elapsed.Compare(chrono.DurationOf(90*chrono.Minute)) != 0 {
+			t.Errorf("elapsed = %s, want PT1H30M", elapsed)
+		}
+
+		if expected := "2020-03-18 11:30:00Z"; c.NowUTC().String() != expected {
+			t.Errorf("c.NowUTC() = %s, want %s", c.NowUTC(), expected)
+		}
+
+		c.Set(chrono.OffsetDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0, 0, 0))
+		if expected := "2021-01-01 00:00:00Z"; c.NowUTC().String() != expected {
+			t.Errorf("c.NowUTC() = %s, want %s", c.NowUTC(), expected)
+		}
+
+		if elapsed := mono.Until(c.Now()); elapsed.Compare(chrono.DurationOf(90*chrono.Minute)) != 0 {
+			t.Errorf("elapsed after Set = %s, want PT1H30M", elapsed)
+		}
+	})
+
+	t.Run("timers", func(t *testing.T) {
+		c := chronotest.NewClock(start)
+
+		var fired []string
+		c.AfterFunc(2*chrono.Hour, func() { fired = append(fired, "2h "+c.NowUTC().String()) })
+		c.AfterFunc(chrono.Hour, func() {
+			fired = append(fired, "1h "+c.NowUTC().String())
+			c.AfterFunc(30*chrono.Minute, func() { fired = append(fired, "1h30m "+c.NowUTC().String()) })
+		})
+		stop := c.AfterFunc(90*chrono.Minute, func() { fired = append(fired, "stopped") })
+
+		if !stop() {
+			t.Error("stop() = false, want true")
+		} else if stop() {
+			t.Error("second stop() = true, want false")
+		}
+
+		c.Advance(chrono.Hour + 45*chrono.Minute)
+		expected := []string{"1h 2020-03-18 11:00:00Z", "1h30m 2020-03-18 11:30:00Z"}
+		if len(fired) != len(expected) || fired[0] != expected[0] || fired[1] != expected[1] {
+			t.Fatalf("fired = %v, want %v", fired, expected)
+		}
+
+		c.Advance(15 * chrono.Minute)
+		if len(fired) != 3 || fired[2] != "2h 2020-03-18 12:00:00Z" {
+			t.Errorf("fired = %v", fired)
+		}
+	})
+
+	t.Run("blocked", func(t *testing.T) {
+		c := chronotest.NewClock(start)
+
+		done := make(chan chrono.Instant)
+		go func() {
+			woken := make(chan struct{})
+			c.AfterFunc(chrono.Minute, func() { close(woken) })
+			<-woken
+			done <- c.Now()
+		}()
+
+		begin := c.Now()
+		c.BlockUntil(1)
+		c.Advance(chrono.Minute)
+
+		if elapsed := begin.Until(<-done); elapsed.Compare(chrono.DurationOf(chrono.Minute)) != 0 {
+			t.Errorf("elapsed = %s, want PT1M", elapsed)
+		}
+	})
+}
@@ -2,6 +2,6 @@ module github.com/foo/bar
 
 go 1.23.4
 
-require github.com/go-chrono/chrono v0.0.0-20240102183611-532f0d0d7c34 // indirect
+require github.com/go-chrono/chrono v0.0.0-20240102183611-532f0d0d7c34
 
 replace github.com/go-chrono/chrono => ../../
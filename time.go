@@ -15,7 +15,7 @@ const (
 
 func makeTime(hour, min, sec, nsec int) (int64, error) {
 	if hour < 0 || hour > 99 || min < 0 || min > 59 || sec < 0 || sec > 59 || nsec < 0 || nsec > 999999999 {
-		return 0, fmt.Errorf("invalid time")
+		return 0, errOutOfRange("invalid time")
 	}
 
 	return int64(hour)*oneHour + int64(min)*oneMinute + int64(sec)*oneSecond + int64(nsec), nil
@@ -26,21 +26,43 @@ func fromTime(v int64) (hour, min, sec, nsec int) {
 	sec = int(v) / int(oneSecond)
 
 	hour = (sec / (60 * 60)) % 24
-	sec -= hour * (60 * 60)
-
-	min = sec / 60
-	sec -= min * 60
+	min = (sec / 60) % 60
+	sec %= 60
 	return
 }
 
+// timeFields returns the fields of the time t, where hour is the business hour, which is not normalized.
+func timeFields(t int64) (hour, min, sec, nsec int) {
+	_, min, sec, nsec = fromTime(t)
+	return timeBusinessHour(t), min, sec, nsec
+}
+
+// makeTimeOfDay returns the time represented by the supplied fields, as by makeTime,
+// but it also requires the time to fall within a single day.
+func makeTimeOfDay(hour, min, sec, nsec int) (int64, error) {
+	if hour > 23 {
+		return 0, errOutOfRange("invalid time")
+	}
+	return makeTime(hour, min, sec, nsec)
+}
+
+// withTimeFields returns the time t with its fields replaced by those returned by f.
+func withTimeFields(t int64, f func(hour, min, sec, nsec int) (int, int, int, int)) int64 {
+	out, err := makeTime(f(timeFields(t)))
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
 func addTime(t, v int64) (int64, error) {
 	if v > maxTime {
-		return 0, fmt.Errorf("invalid duration v")
+		return 0, errOutOfRange("invalid duration v")
 	}
 
 	out := t + v
 	if out > int64(maxTime) {
-		return 0, fmt.Errorf("invalid time t+v")
+		return 0, errOutOfRange("invalid time t+v")
 	}
 
 	if out < 0 {
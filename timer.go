@@ -0,0 +1,308 @@
+package chrono
+
+import (
+	"math"
+	"math/big"
+	"sync"
+)
+
+// The functions in this file wait for time to pass according to a Clock. Those without a Clock parameter use SystemClock,
+// while those with the suffix On accept any Clock, such that code using them can be tested without real time passing.
+// Durations that are too long to be represented by an Extent are treated as the maximum Extent, approximately 292 years.
+
+// maxWallWait is the longest that functions waiting for a wall clock reading sleep without reading the wall clock again.
+// It bounds how late they are when the wall clock is adjusted while they are waiting.
+const maxWallWait = Second
+
+// Sleep pauses the current goroutine for at least the duration d.
+// A negative or zero duration causes Sleep to return immediately.
+func Sleep(d Duration) {
+	SleepOn(SystemClock(), d)
+}
+
+// SleepOn is like Sleep, but waits according to the monotonic clock provided by c.
+func SleepOn(c Clock, d Duration) {
+	e := clampExtent(d)
+	if e <= 0 {
+		return
+	}
+
+	done := make(chan struct{})
+	c.AfterFunc(e, func() { close(done) })
+	<-done
+}
+
+// SleepUntil pauses the current goroutine until the wall clock reads t or later.
+// Unlike sleeping for the duration until t, SleepUntil tolerates adjustments to the wall clock that are made while it is waiting:
+// if the wall clock is moved backwards, it continues to wait, and if it is moved forwards past t, it returns shortly after.
+func SleepUntil(t OffsetDateTime) {
+	SleepUntilOn(SystemClock(), t)
+}
+
+// SleepUntilOn is like SleepUntil, but reads the wall clock provided by c.
+func SleepUntilOn(c Clock, t OffsetDateTime) {
+	for {
+		e := wallUntil(c, t)
+		if e <= 0 {
+			return
+		} else if e > maxWallWait {
+			e = maxWallWait
+		}
+		SleepOn(c, DurationOf(e))
+	}
+}
+
+// After waits for the duration d to elapse, and then sends the current Instant on the returned channel.
+// It is equivalent to NewTimer(d).C.
+func After(d Duration) <-chan Instant {
+	return NewTimer(d).C
+}
+
+// AfterOn is like After, but waits according to the monotonic clock provided by c.
+func AfterOn(c Clock, d Duration) <-chan Instant {
+	return NewTimerOn(c, d).C
+}
+
+// Timer represents a single event. When the Timer expires, the current Instant is sent on C.
+// A Timer must be created using NewTimer or NewTimerOn.
+type Timer struct {
+	C <-chan Instant
+
+	c    Clock
+	ch   chan Instant
+	mu   sync.Mutex
+	stop func() bool
+}
+
+// NewTimer creates a new Timer that sends the current Instant on its channel after at least the duration d.
+func NewTimer(d Duration) *Timer {
+	return NewTimerOn(SystemClock(), d)
+}
+
+// NewTimerOn is like NewTimer, but waits according to the monotonic clock provided by c.
+func NewTimerOn(c Clock, d Duration) *Timer {
+	ch := make(chan Instant, 1)
+	t := &Timer{C: ch, c: c, ch: ch}
+	t.stop = c.AfterFunc(clampExtent(d), t.fire)
+	return t
+}
+
+func (t *Timer) fire() {
+	select {
+	case t.ch <- t.c.Now():
+	default:
+	}
+}
+
+// Stop prevents the Timer from firing. It returns true if the call stops the timer,
+// and false if the timer has already expired or been stopped. Stop does not drain C.
+func (t *Timer) Stop() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stop()
+}
+
+// Reset changes the timer to expire after the duration d.
+// It returns true if the timer had been active, and false if it had expired or been stopped.
+func (t *Timer) Reset(d Duration) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	active := t.stop()
+	t.stop = t.c.AfterFunc(clampExtent(d), t.fire)
+	return active
+}
+
+// Ticker delivers the current Instant on C at regular intervals.
+// The intervals are measured from when the Ticker was created or reset, and so do not drift.
+// If the receiver falls behind, ticks are dropped in the same manner as the standard library's time.Ticker.
+// A Ticker must be created using NewTicker or NewTickerOn.
+type Ticker struct {
+	C <-chan Instant
+
+	c    Clock
+	ch   chan Instant
+	mu   sync.Mutex
+	d    Extent
+	next Instant
+	gen  int
+	stop func() bool
+}
+
+// NewTicker returns a new Ticker that sends the current Instant on its channel every d.
+// It panics if d is not positive.
+func NewTicker(d Extent) *Ticker {
+	return NewTickerOn(SystemClock(), d)
+}
+
+// NewTickerOn is like NewTicker, but measures its intervals using the monotonic clock provided by c.
+func NewTickerOn(c Clock, d Extent) *Ticker {
+	ch := make(chan Instant, 1)
+	t := &Ticker{C: ch, c: c, ch: ch}
+	t.Reset(d)
+	return t
+}
+
+// Stop turns off the ticker, after which no more ticks are sent. Stop does not close C.
+func (t *Ticker) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.gen++
+	t.stop()
+}
+
+// Reset stops the ticker and resets its interval to d, with the next tick arriving after d.
+// It panics if d is not positive.
+func (t *Ticker) Reset(d Extent) {
+	if d <= 0 {
+		panic("non-positive interval for ticker")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stop != nil {
+		t.stop()
+	}
+
+	t.gen++
+	t.d = d
+	t.next = t.c.Now().Add(DurationOf(d))
+	t.schedule()
+}
+
+func (t *Ticker) schedule() {
+	gen := t.gen
+	t.stop = t.c.AfterFunc(clampExtent(t.next.Sub(t.c.Now())), func() { t.tick(gen) })
+}
+
+func (t *Ticker) tick(gen int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if gen != t.gen {
+		return
+	}
+
+	now := t.c.Now()
+	select {
+	case t.ch <- now:
+	default:
+	}
+
+	// Skip over any ticks that were missed, such as while the system was suspended, in a single step.
+	if !t.next.After(now) {
+		t.next.v += ((now.v-t.next.v)/int64(t.d) + 1) * int64(t.d)
+	}
+	t.schedule()
+}
+
+// AlignedTicker delivers the wall clock time of each boundary between intervals of a fixed length on C,
+// such as the start of each minute, hour or day at a particular offset.
+// Ticks are measured using the wall clock, and so adjustments to the wall clock are tolerated in the same manner as SleepUntil:
+// when the wall clock is moved forwards, only the most recent of the boundaries that it skipped over is delivered,
+// and when it is moved backwards, the ticker waits for the next boundary after the new reading.
+// An AlignedTicker must be created using NewAlignedTicker or NewAlignedTickerOn.
+type AlignedTicker struct {
+	C <-chan OffsetDateTime
+
+	c        Clock
+	ch       chan OffsetDateTime
+	mu       sync.Mutex
+	step     Extent
+	offset   Offset
+	boundary OffsetDateTime
+	stopped  bool
+	stop     func() bool
+}
+
+// NewAlignedTicker returns a new AlignedTicker that sends the time of each boundary between intervals of length step,
+// counted from midnight at the specified offset. For example, a step of Hour ticks at the start of every hour.
+// It panics if step is not positive, or does not evenly divide a day.
+func NewAlignedTicker(step Extent, offset Offset) *AlignedTicker {
+	return NewAlignedTickerOn(SystemClock(), step, offset)
+}
+
+// NewAlignedTickerOn is like NewAlignedTicker, but reads the wall clock provided by c.
+func NewAlignedTickerOn(c Clock, step Extent, offset Offset) *AlignedTicker {
+	if step <= 0 || (24*Hour)%step != 0 {
+		panic("ticker step must evenly divide a day")
+	}
+
+	ch := make(chan OffsetDateTime, 1)
+	t := &AlignedTicker{C: ch, c: c, ch: ch, step: step, offset: offset}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.boundary = t.nextBoundary(c.NowUTC())
+	t.schedule()
+	return t
+}
+
+// Stop turns off the ticker, after which no more ticks are sent. Stop does not close C.
+func (t *AlignedTicker) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.stopped = true
+	t.stop()
+}
+
+// nextBoundary returns the first boundary after now.
+func (t *AlignedTicker) nextBoundary(now OffsetDateTime) OffsetDateTime {
+	now = now.In(t.offset)
+
+	var tod big.Int
+	new(big.Int).DivMod(&now.v, bigIntDayExtent, &tod)
+	return now.Add(DurationOf(t.step - Extent(tod.Int64())%t.step))
+}
+
+func (t *AlignedTicker) schedule() {
+	e := wallUntil(t.c, t.boundary)
+	if e > maxWallWait {
+		e = maxWallWait
+	}
+	t.stop = t.c.AfterFunc(e, t.tick)
+}
+
+func (t *AlignedTicker) tick() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stopped {
+		return
+	}
+
+	// Only the most recent boundary is delivered, as described for AlignedTicker.
+	next := t.nextBoundary(t.c.NowUTC())
+	if last := next.Add(DurationOf(-t.step)); last.compareUTC(t.boundary) >= 0 {
+		select {
+		case t.ch <- last:
+		default:
+		}
+		t.boundary = next
+	} else if next.compareUTC(t.boundary) < 0 {
+		t.boundary = next
+	}
+	t.schedule()
+}
+
+// wallUntil returns the extent until the wall clock provided by c reads t.
+func wallUntil(c Clock, t OffsetDateTime) Extent {
+	v, now := t.utc(), c.NowUTC().utc()
+	return clampExtent(Duration{v: *v.Sub(&v, &now)})
+}
+
+// clampExtent converts d to an Extent, limiting it to the range of Extent.
+func clampExtent(d Duration) Extent {
+	switch {
+	case d.v.IsInt64():
+		return Extent(d.v.Int64())
+	case d.v.Sign() < 0:
+		return math.MinInt64
+	default:
+		return math.MaxInt64
+	}
+}
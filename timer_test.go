@@ -0,0 +1,185 @@
+package chrono_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/go-chrono/chrono"
+	chronotest "github.com/go-chrono/chrono/test"
+)
+
+func TestSleepOn(t *testing.T) {
+	c := chronotest.NewClock(chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 0, 0))
+	start := c.Now()
+
+	done := make(chan chrono.Instant)
+	go func() {
+		chrono.SleepOn(c, chrono.DurationOf(chrono.Hour))
+		done <- c.Now()
+	}()
+
+	c.BlockUntil(1)
+	c.Advance(chrono.Hour)
+	if d := start.Until(<-done); d.Compare(chrono.DurationOf(chrono.Hour)) != 0 {
+		t.Errorf("slept for %s, want PT1H", d)
+	}
+}
+
+func TestSleepUntilOn(t *testing.T) {
+	c := chronotest.NewClock(chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 2, 0))
+
+	done := make(chan struct{})
+	go func() {
+		chrono.SleepUntilOn(c, chrono.OffsetDateTimeOf(2020, chrono.March, 18, 11, 0, 0, 0, 0, 0))
+		close(done)
+	}()
+
+	c.BlockUntil(1)
+	c.Set(chrono.OffsetDateTimeOf(2020, chrono.March, 18, 11, 30, 0, 0, 0, 0))
+	c.Advance(chrono.Second)
+	<-done
+}
+
+func TestTimer(t *testing.T) {
+	c := chronotest.NewClock(chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 0, 0))
+	start := c.Now()
+
+	timer := chrono.NewTimerOn(c, chrono.DurationOf(chrono.Minute))
+	c.Advance(chrono.Minute)
+	if d := start.Until(<-timer.C); d.Compare(chrono.DurationOf(chrono.Minute)) != 0 {
+		t.Errorf("timer fired after %s, want PT1M", d)
+	}
+
+	if timer.Stop() {
+		t.Error("timer.Stop() = true after firing")
+	}
+
+	if timer.Reset(chrono.DurationOf(chrono.Minute)) {
+		t.Error("timer.Reset() = true after firing")
+	}
+
+	if !timer.Stop() {
+		t.Error("timer.Stop() = false after reset")
+	}
+
+	c.Advance(chrono.Hour)
+	select {
+	case <-timer.C:
+		t.Error("stopped timer fired")
+	default:
+	}
+
+	after := chrono.AfterOn(c, chrono.DurationOf(chrono.Second))
+	c.Advance(chrono.Second)
+	<-after
+}
+
+func TestTicker(t *testing.T) {
+	c := chronotest.NewClock(chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 0, 0))
+	start := c.Now()
+
+	ticker := chrono.NewTickerOn(c, chrono.Minute)
+	defer ticker.Stop()
+
+	c.Advance(chrono.Minute)
+	if d := start.Until(<-ticker.C); d.Compare(chrono.DurationOf(chrono.Minute)) != 0 {
+		t.Errorf("tick after %s, want PT1M", d)
+	}
+
+	c.Advance(3*chrono.Minute + 30*chrono.Second)
+	if d := start.Until(<-ticker.C); d.Compare(chrono.DurationOf(2*chrono.Minute)) != 0 {
+		t.Errorf("tick after %s, want PT2M", d)
+	}
+
+	c.Advance(30 * chrono.Second)
+	if d := start.Until(<-ticker.C); d.Compare(chrono.DurationOf(5*chrono.Minute)) != 0 {
+		t.Errorf("tick after %s, want PT5M", d)
+	}
+
+	ticker.Reset(chrono.Hour)
+	c.Advance(chrono.Minute)
+	select {
+	case <-ticker.C:
+		t.Error("unexpected tick after reset")
+	default:
+	}
+}
+
+// stallClock is a Clock whose timers only fire when fire is called, regardless of its reading,
+// in order to simulate timers that fire late, such as after the system was suspended.
+type stallClock struct {
+	mu  sync.Mutex
+	now chrono.Instant
+	d   chrono.Extent
+	f   func()
+}
+
+func (c *stallClock) Now() chrono.Instant {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *stallClock) NowUTC() chrono.OffsetDateTime {
+	return chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 0, 0)
+}
+
+func (c *stallClock) AfterFunc(d chrono.Extent, f func()) func() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.d, c.f = d, f
+	return func() bool { return false }
+}
+
+func (c *stallClock) fire(now chrono.Instant) {
+	c.mu.Lock()
+	c.now = now
+	f := c.f
+	c.mu.Unlock()
+	f()
+}
+
+func TestTicker_stall(t *testing.T) {
+	c := &stallClock{now: chronotest.InstantOf(1)}
+
+	ticker := chrono.NewTickerOn(c, chrono.Microsecond)
+	defer ticker.Stop()
+
+	c.fire(chronotest.InstantOf(1 + int64(24*chrono.Hour)))
+	if tick := <-ticker.C; tick != chronotest.InstantOf(1+int64(24*chrono.Hour)) {
+		t.Errorf("tick = %s, want %d", tick, 1+int64(24*chrono.Hour))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.d != chrono.Microsecond {
+		t.Errorf("next tick scheduled after %s, want 1µs", c.d)
+	}
+}
+
+func TestAlignedTicker(t *testing.T) {
+	c := chronotest.NewClock(chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 30, 0, 2, 0))
+
+	ticker := chrono.NewAlignedTickerOn(c, chrono.Minute, chrono.OffsetOf(2, 0))
+	defer ticker.Stop()
+
+	c.Advance(30 * chrono.Second)
+	if tick := <-ticker.C; tick.String() != "2020-03-18 12:01:00+02:00" {
+		t.Errorf("tick = %s, want 2020-03-18 12:01:00+02:00", tick)
+	}
+
+	c.Set(chrono.OffsetDateTimeOf(2020, chrono.March, 18, 11, 5, 59, 0, 0, 0))
+	c.Advance(chrono.Second)
+	if tick := <-ticker.C; tick.String() != "2020-03-18 13:06:00+02:00" {
+		t.Errorf("tick = %s, want 2020-03-18 13:06:00+02:00", tick)
+	}
+
+	t.Run("invalid step", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expecting panic")
+			}
+		}()
+		chrono.NewAlignedTickerOn(c, 7*chrono.Minute, chrono.UTC)
+	})
+}
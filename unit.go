@@ -0,0 +1,197 @@
+package chrono
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Unit specifies a unit of time, such as a second, or a unit of the calendar, such as a day or a month.
+type Unit int
+
+// The units of time and of the calendar.
+const (
+	UnitNanosecond Unit = iota + 1
+	UnitMicrosecond
+	UnitMillisecond
+	UnitSecond
+	UnitMinute
+	UnitHour
+	UnitDay        // A calendar day, which starts at midnight.
+	UnitWeek       // An ISO 8601 week, which starts on Monday.
+	UnitMonth      // A calendar month.
+	UnitQuarter    // A quarter of a calendar year, starting in January, April, July or October.
+	UnitYear       // A calendar year.
+	UnitDecade     // A decade, starting in a year that is a multiple of 10, such as 2020.
+	UnitCentury    // A century, starting in a year that is a multiple of 100, such as 2000.
+	UnitMillennium // A millennium, starting in a year that is a multiple of 1000, such as 2000.
+)
+
+func (u Unit) String() string {
+	if u < UnitNanosecond || u > UnitMillennium {
+		return fmt.Sprintf("%%!Unit(%d)", u)
+	}
+	return unitNames[u-UnitNanosecond]
+}
+
+var unitNames = [14]string{
+	UnitNanosecond - UnitNanosecond:  "Nanosecond",
+	UnitMicrosecond - UnitNanosecond: "Microsecond",
+	UnitMillisecond - UnitNanosecond: "Millisecond",
+	UnitSecond - UnitNanosecond:      "Second",
+	UnitMinute - UnitNanosecond:      "Minute",
+	UnitHour - UnitNanosecond:        "Hour",
+	UnitDay - UnitNanosecond:         "Day",
+	UnitWeek - UnitNanosecond:        "Week",
+	UnitMonth - UnitNanosecond:       "Month",
+	UnitQuarter - UnitNanosecond:     "Quarter",
+	UnitYear - UnitNanosecond:        "Year",
+	UnitDecade - UnitNanosecond:      "Decade",
+	UnitCentury - UnitNanosecond:     "Century",
+	UnitMillennium - UnitNanosecond:  "Millennium",
+}
+
+// extent returns the length of u if it is a unit of time, including UnitDay.
+func (u Unit) extent() (Extent, bool) {
+	switch u {
+	case UnitNanosecond:
+		return Nanosecond, true
+	case UnitMicrosecond:
+		return Microsecond, true
+	case UnitMillisecond:
+		return Millisecond, true
+	case UnitSecond:
+		return Second, true
+	case UnitMinute:
+		return Minute, true
+	case UnitHour:
+		return Hour, true
+	case UnitDay:
+		return 24 * Hour, true
+	}
+	return 0, false
+}
+
+// months returns the number of months in u if it is a unit of the calendar that consists of whole months.
+func (u Unit) months() (int, bool) {
+	switch u {
+	case UnitMonth:
+		return 1, true
+	case UnitQuarter:
+		return 3, true
+	case UnitYear:
+		return 12, true
+	case UnitDecade:
+		return 120, true
+	case UnitCentury:
+		return 1200, true
+	case UnitMillennium:
+		return 12000, true
+	}
+	return 0, false
+}
+
+// truncateDateToUnit returns the first date of the unit in which the date d occurs.
+func truncateDateToUnit(d int64, u Unit) (int64, error) {
+	year, month, day, err := fromDate(d)
+	if err != nil {
+		return 0, err
+	}
+
+	switch u {
+	case UnitDay:
+		return d, nil
+	case UnitWeek:
+		d -= int64(getWeekday(int32(d)) - int(Monday))
+	case UnitMonth:
+		d -= int64(day - 1)
+	case UnitQuarter:
+		d = makeJDN(int64(year), int64((month-1)/3*3+1), 1)
+	case UnitYear, UnitDecade, UnitCentury, UnitMillennium:
+		n, _ := u.months()
+		d = makeJDN(floorDiv(int64(year), int64(n/12))*int64(n/12), int64(January), 1)
+	default:
+		return 0, errUnsupportedUnit(u)
+	}
+
+	if d < minJDN {
+		return 0, errOutOfRange("date out of bounds")
+	}
+	return d, nil
+}
+
+// addUnitsToDate returns the date d plus n of the unit u, in the same manner as AddDate.
+func addUnitsToDate(d int64, u Unit, n int) (int64, error) {
+	// The range of dates is less than 2^31 days, so larger values can never produce a valid date.
+	if n > math.MaxInt32 || n < math.MinInt32 {
+		return 0, errOutOfRange("date out of bounds")
+	}
+
+	var months, days int
+	if m, ok := u.months(); ok {
+		months = n * m
+	} else if u == UnitDay {
+		days = n
+	} else if u == UnitWeek {
+		days = n * 7
+	} else {
+		return 0, errUnsupportedUnit(u)
+	}
+
+	out, err := addDateToDate(d, 0, months, days)
+	if err != nil {
+		return 0, err
+	} else if out < minJDN || out > maxJDN {
+		return 0, errOutOfRange("date out of bounds")
+	}
+	return out, nil
+}
+
+// plusBigDate returns the datetime v plus n of the unit u.
+// Units of the calendar are added in the same manner as AddDate, retaining the time of day.
+func plusBigDate(v big.Int, n int64, u Unit) (big.Int, error) {
+	if e, ok := u.extent(); ok {
+		d := big.NewInt(n)
+		return addDurationToBigDate(v, Duration{v: *d.Mul(d, big.NewInt(int64(e)))})
+	}
+
+	date, time := splitDateAndTime(v)
+	out, err := addUnitsToDate(date, u, int(n))
+	if err != nil {
+		return big.Int{}, err
+	}
+	return checkBigDate(makeDateTime(out, time))
+}
+
+// untilBigDate returns the number of whole units u between the datetimes a and b, which is negative if b is before a.
+// Months and larger units are counted in the same manner as BetweenDateTimes.
+func untilBigDate(a, b big.Int, u Unit) (int64, error) {
+	var e Extent
+	if u == UnitWeek {
+		e = 7 * 24 * Hour
+	} else if m, ok := u.months(); ok {
+		p, _ := betweenDateTimes(a, b)
+		return int64(p.Years*12+p.Months) / int64(m), nil
+	} else if e, ok = u.extent(); !ok {
+		return 0, errUnsupportedUnit(u)
+	}
+
+	var out big.Int
+	out.Sub(&b, &a)
+	if out.Quo(&out, big.NewInt(int64(e))); !out.IsInt64() {
+		return 0, errOverflow("number of units out of range")
+	}
+	return out.Int64(), nil
+}
+
+// floorDiv returns a/b rounded towards negative infinity, where b is positive.
+func floorDiv(a, b int64) int64 {
+	if a < 0 {
+		return (a - b + 1) / b
+	}
+	return a / b
+}
+
+func errUnsupportedUnit(u Unit) error {
+	return fmt.Errorf("unsupported unit %s", u)
+}
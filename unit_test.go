@@ -0,0 +1,210 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestUnit_String(t *testing.T) {
+	for _, tt := range []struct {
+		unit     chrono.Unit
+		expected string
+	}{
+		{chrono.UnitNanosecond, "Nanosecond"},
+		{chrono.UnitHour, "Hour"},
+		{chrono.UnitWeek, "Week"},
+		{chrono.UnitMillennium, "Millennium"},
+		{0, "%!Unit(0)"},
+		{chrono.UnitMillennium + 1, "%!Unit(15)"},
+	} {
+		t.Run(tt.expected, func(t *testing.T) {
+			if str := tt.unit.String(); str != tt.expected {
+				t.Errorf("unit.String() = %s, want %s", str, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocalDate_Plus(t *testing.T) {
+	d := chrono.LocalDateOf(2021, chrono.January, 31)
+
+	for _, tt := range []struct {
+		name     string
+		out      chrono.LocalDate
+		expected chrono.LocalDate
+	}{
+		{"day", d.Plus(1, chrono.UnitDay), chrono.LocalDateOf(2021, chrono.February, 1)},
+		{"week", d.Plus(2, chrono.UnitWeek), chrono.LocalDateOf(2021, chrono.February, 14)},
+		{"month", d.Plus(1, chrono.UnitMonth), chrono.LocalDateOf(2021, chrono.March, 3)},
+		{"quarter", d.Plus(1, chrono.UnitQuarter), chrono.LocalDateOf(2021, chrono.May, 1)},
+		{"decade", d.Plus(1, chrono.UnitDecade), chrono.LocalDateOf(2031, chrono.January, 31)},
+		{"century", d.Minus(1, chrono.UnitCentury), chrono.LocalDateOf(1921, chrono.January, 31)},
+		{"millennium", d.Plus(1, chrono.UnitMillennium), chrono.LocalDateOf(3021, chrono.January, 31)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.out != tt.expected {
+				t.Errorf("out = %s, want %s", tt.out, tt.expected)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name     string
+		d2       chrono.LocalDate
+		unit     chrono.Unit
+		expected int64
+	}{
+		{"days", chrono.LocalDateOf(2021, chrono.January, 30), chrono.UnitDay, -1},
+		{"weeks", chrono.LocalDateOf(2021, chrono.February, 14), chrono.UnitWeek, 2},
+		{"incomplete month", chrono.LocalDateOf(2021, chrono.March, 2), chrono.UnitMonth, 0},
+		{"years", chrono.LocalDateOf(2023, chrono.February, 1), chrono.UnitYear, 2},
+		{"negative years", chrono.LocalDateOf(2020, chrono.January, 31), chrono.UnitYear, -1},
+		{"decade", chrono.LocalDateOf(2031, chrono.January, 31), chrono.UnitDecade, 1},
+		{"incomplete decade", chrono.LocalDateOf(2031, chrono.January, 30), chrono.UnitDecade, 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if n := d.Until(tt.d2, tt.unit); n != tt.expected {
+				t.Errorf("d.Until(%s, %s) = %d, want %d", tt.d2, tt.unit, n, tt.expected)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name string
+		f    func()
+	}{
+		{"unsupported unit", func() { d.Plus(1, chrono.UnitHour) }},
+		{"unsupported unit for Until", func() { d.Until(d+1, chrono.UnitHour) }},
+		{"out of range", func() { d.Plus(1<<40, chrono.UnitDay) }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expecting panic")
+				}
+			}()
+			tt.f()
+		})
+	}
+}
+
+func TestLocalTime_Plus(t *testing.T) {
+	tm := chrono.LocalTimeOf(12, 0, 0, 0)
+
+	if out := tm.Plus(90, chrono.UnitMinute); out.Compare(chrono.LocalTimeOf(13, 30, 0, 0)) != 0 {
+		t.Errorf("tm.Plus() = %s, want 13:30:00", out)
+	}
+
+	if out := tm.Minus(13, chrono.UnitHour); out.Compare(chrono.LocalTimeOf(23, 0, 0, 0)) != 0 {
+		t.Errorf("tm.Minus() = %s, want 23:00:00", out)
+	}
+
+	if n := tm.Until(chrono.LocalTimeOf(14, 30, 0, 0), chrono.UnitHour); n != 2 {
+		t.Errorf("tm.Until() = %d, want 2", n)
+	}
+
+	if n := tm.Until(chrono.LocalTimeOf(11, 59, 0, 0), chrono.UnitMinute); n != -1 {
+		t.Errorf("tm.Until() = %d, want -1", n)
+	}
+
+	t.Run("unsupported unit", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expecting panic")
+			}
+		}()
+		tm.Plus(1, chrono.UnitDay)
+	})
+
+	t.Run("unsupported unit for Until", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expecting panic")
+			}
+		}()
+		tm.Until(tm, chrono.UnitDay)
+	})
+}
+
+func TestLocalDateTime_Plus(t *testing.T) {
+	dt := chrono.LocalDateTimeOf(2021, chrono.January, 31, 23, 0, 0, 0)
+
+	for _, tt := range []struct {
+		name     string
+		out      chrono.LocalDateTime
+		expected chrono.LocalDateTime
+	}{
+		{"hours", dt.Plus(2, chrono.UnitHour), chrono.LocalDateTimeOf(2021, chrono.February, 1, 1, 0, 0, 0)},
+		{"day", dt.Plus(1, chrono.UnitDay), chrono.LocalDateTimeOf(2021, chrono.February, 1, 23, 0, 0, 0)},
+		{"month", dt.Plus(1, chrono.UnitMonth), chrono.LocalDateTimeOf(2021, chrono.March, 3, 23, 0, 0, 0)},
+		{"minus nanoseconds", dt.Minus(1, chrono.UnitNanosecond), chrono.LocalDateTimeOf(2021, chrono.January, 31, 22, 59, 59, 999999999)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.out.Compare(tt.expected) != 0 {
+				t.Errorf("out = %s, want %s", tt.out, tt.expected)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name     string
+		d2       chrono.LocalDateTime
+		unit     chrono.Unit
+		expected int64
+	}{
+		{"incomplete month", chrono.LocalDateTimeOf(2021, chrono.February, 28, 22, 0, 0, 0), chrono.UnitMonth, 0},
+		{"month", chrono.LocalDateTimeOf(2021, chrono.March, 3, 23, 0, 0, 0), chrono.UnitMonth, 1},
+		{"incomplete year", chrono.LocalDateTimeOf(2022, chrono.January, 31, 22, 59, 0, 0), chrono.UnitYear, 0},
+		{"seconds", chrono.LocalDateTimeOf(2021, chrono.February, 1, 0, 0, 0, 0), chrono.UnitSecond, 3600},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if n := dt.Until(tt.d2, tt.unit); n != tt.expected {
+				t.Errorf("dt.Until(%s, %s) = %d, want %d", tt.d2, tt.unit, n, tt.expected)
+			}
+		})
+	}
+
+	t.Run("overflow", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expecting panic")
+			}
+		}()
+		chrono.MinLocalDateTime().Until(chrono.MaxLocalDateTime(), chrono.UnitNanosecond)
+	})
+}
+
+func TestOffsetDateTime_Plus(t *testing.T) {
+	dt := chrono.OffsetDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0, 2, 0)
+
+	if out := dt.Plus(1, chrono.UnitYear); out.String() != "2022-01-01 00:00:00+02:00" {
+		t.Errorf("dt.Plus() = %s", out)
+	}
+
+	if n := dt.Until(chrono.OffsetDateTimeOf(2020, chrono.December, 31, 23, 0, 0, 0, 0, 0), chrono.UnitHour); n != 1 {
+		t.Errorf("dt.Until() = %d, want 1", n)
+	}
+}
+
+func TestLocalDateTime_TruncateLongUnits(t *testing.T) {
+	dt := chrono.LocalDateTimeOf(2021, chrono.May, 19, 12, 0, 0, 0)
+
+	for _, tt := range []struct {
+		name     string
+		out      chrono.LocalDateTime
+		expected chrono.LocalDateTime
+	}{
+		{"decade", dt.Truncate(chrono.UnitDecade), chrono.LocalDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0)},
+		{"ceil decade", dt.Ceil(chrono.UnitDecade), chrono.LocalDateTimeOf(2030, chrono.January, 1, 0, 0, 0, 0)},
+		{"century", dt.Truncate(chrono.UnitCentury), chrono.LocalDateTimeOf(2000, chrono.January, 1, 0, 0, 0, 0)},
+		{"millennium", dt.Truncate(chrono.UnitMillennium), chrono.LocalDateTimeOf(2000, chrono.January, 1, 0, 0, 0, 0)},
+		{"negative decade", chrono.LocalDateTimeOf(-15, chrono.June, 1, 0, 0, 0, 0).Truncate(chrono.UnitDecade), chrono.LocalDateTimeOf(-20, chrono.January, 1, 0, 0, 0, 0)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.out.Compare(tt.expected) != 0 {
+				t.Errorf("out = %s, want %s", tt.out, tt.expected)
+			}
+		})
+	}
+}
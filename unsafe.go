@@ -7,8 +7,8 @@ import (
 //go:linkname monotime runtime.nanotime
 func monotime() int64
 
-//go:linkname walltime runtime.walltime
-func walltime() (secs int64, nsec int32)
+//go:linkname walltime time.now
+func walltime() (secs int64, nsec int32, mono int64)
 
 //go:linkname zoneSources time.zoneSources
 var zoneSources []string
@@ -26,6 +26,19 @@ func addInt64(v1, v2 int64) (sum int64, underflows, overflows bool) {
 	return v1 + v2, false, false
 }
 
+// mulInt64 attempts to multiply v1 by v2 but reports false if the operation would underflow or overflow int64.
+func mulInt64(v1, v2 int64) (product int64, ok bool) {
+	if v1 == 0 || v2 == 0 {
+		return 0, true
+	}
+
+	out := v1 * v2
+	if out/v2 != v1 || (v1 == -1 && v2 == math.MinInt64) || (v2 == -1 && v1 == math.MinInt64) {
+		return 0, false
+	}
+	return out, true
+}
+
 // divideAndRoundInt divides x by y, then rounds the result to the nearest multiple of y, either up or down.
 func divideAndRoundInt(x, y int) int {
 	r := x % y
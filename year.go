@@ -0,0 +1,104 @@
+package chrono
+
+import "fmt"
+
+// Year is a year in the ISO 8601 calendar, such as 2006, without a month, day, time or time zone.
+// As with LocalDate, year 0 is interpreted to mean 1 BCE, and year -1 is 2 BCE, and so on.
+//
+// Since Year is an integer, any two Years can be compared to each other using the standard comparison operators,
+// and the difference between them is the number of years between them.
+// The supported range of Years is the range of years that contain at least one date that can be represented by LocalDate.
+type Year int32
+
+// IsLeap reports whether y is a leap year (contains 29th February, and thus 366 days instead of 365).
+func (y Year) IsLeap() bool {
+	return isLeapYear(int(y))
+}
+
+// Length returns the number of days in y, which is 365, or 366 in leap years.
+func (y Year) Length() int {
+	return getDaysInYear(int(y))
+}
+
+// AtDay returns the LocalDate that represents the specified day of y, in the same manner as OfDayOfYear.
+// This function panics if the day does not exist in the year, or if the date cannot be represented.
+func (y Year) AtDay(day int) LocalDate {
+	return OfDayOfYear(int(y), day)
+}
+
+// AtMonth returns the YearMonth that represents the specified month of y.
+// This function panics if month is not valid, or if the year and month cannot be represented.
+func (y Year) AtMonth(month Month) YearMonth {
+	return YearMonthOf(int(y), month)
+}
+
+// AtMonthDay returns the LocalDate that represents md in y, in the same manner as MonthDay.AtYear.
+func (y Year) AtMonthDay(md MonthDay) LocalDate {
+	return md.AtYear(int(y))
+}
+
+func (y Year) String() string {
+	return fmt.Sprintf("%04d", int32(y))
+}
+
+// Format returns a textual representation of y formatted according to the layout defined by the argument.
+// See the constants section of the documentation to see how to represent the layout format.
+// Month and day specifiers are formatted as if for the first day of the year that can be represented.
+// This function panics if y cannot be represented, or if time format specifiers are encountered in the layout.
+func (y Year) Format(layout string) string {
+	v, err := yearToDate(int(y))
+	if err != nil {
+		panic(err.Error())
+	}
+
+	date := int32(v)
+	out, err := formatDateTimeOffset(layout, &date, nil, nil)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// Parse a formatted string and store the value it represents in y.
+// See the constants section of the documentation to see how to represent the layout format.
+// Any month and day parsed from the value are discarded. Time format specifiers encountered in the layout results in a panic.
+func (y *Year) Parse(layout, value string) error {
+	v, err := yearToDate(int(*y))
+	if err != nil {
+		return err
+	}
+
+	if err := parseDateAndTime(layout, value, &v, nil, nil); err != nil {
+		return err
+	}
+
+	year, _, _, err := fromDate(v)
+	if err != nil {
+		return err
+	}
+
+	*y = Year(year)
+	return nil
+}
+
+// MinYear returns the earliest supported Year.
+func MinYear() Year {
+	return Year(minYear)
+}
+
+// MaxYear returns the latest supported Year.
+func MaxYear() Year {
+	return Year(maxYear)
+}
+
+// yearToDate returns the first date of the year that can be represented, for use in formatting and parsing.
+func yearToDate(year int) (int64, error) {
+	if year < minYear || year > maxYear {
+		return 0, errOutOfRange("year out of bounds")
+	}
+
+	if out := makeJDN(int64(year), int64(January), 1); out > minJDN {
+		return out, nil
+	}
+	return minJDN, nil
+}
@@ -0,0 +1,192 @@
+package chrono
+
+import "fmt"
+
+// YearMonth is a month of a particular year, such as January 2006, without a day, time or time zone.
+// It is useful for values such as credit card expiry dates and billing periods.
+//
+// YearMonth is encoded as the number of months since January 1970, which is represented by the default value, 0.
+// As with LocalDate, any two YearMonths can be compared to each other using the standard comparison operators,
+// and the difference between them is the number of months between them.
+// The supported range of YearMonths is the range of months that contain at least one date that can be represented by LocalDate.
+type YearMonth int32
+
+// YearMonthOf returns the YearMonth that represents the specified year and month.
+// This function panics if month is not valid, or if the year and month cannot be represented.
+func YearMonthOf(year int, month Month) YearMonth {
+	out, err := makeYearMonth(year, int(month))
+	if err != nil {
+		panic(err.Error())
+	}
+	return YearMonth(out)
+}
+
+// NewYearMonth returns the YearMonth that represents the specified year and month, in the same manner as YearMonthOf.
+// Instead of panicking, it returns an error matching ErrInvalidDate if month is not valid,
+// or ErrOutOfRange if the year and month cannot be represented.
+func NewYearMonth(year int, month Month) (YearMonth, error) {
+	out, err := makeYearMonth(year, int(month))
+	if err != nil {
+		return 0, err
+	}
+	return YearMonth(out), nil
+}
+
+// Date returns the ISO 8601 year and month represented by ym.
+func (ym YearMonth) Date() (year int, month Month) {
+	_year, _month := fromYearMonth(int64(ym))
+	return _year, Month(_month)
+}
+
+// Year returns the year specified by ym.
+func (ym YearMonth) Year() int {
+	year, _ := ym.Date()
+	return year
+}
+
+// Month returns the month of the year specified by ym.
+func (ym YearMonth) Month() Month {
+	_, month := ym.Date()
+	return month
+}
+
+// IsLeapYear reports whether the year of ym is a leap year.
+func (ym YearMonth) IsLeapYear() bool {
+	return isLeapYear(ym.Year())
+}
+
+// LengthOfMonth returns the number of days in ym, in the range [28,31].
+func (ym YearMonth) LengthOfMonth() int {
+	year, month := ym.Date()
+	return getDaysInMonth(year, int(month))
+}
+
+// AtDay returns the LocalDate that represents the specified day of ym.
+// This function panics if the day does not exist in the month, or if the date cannot be represented.
+func (ym YearMonth) AtDay(day int) LocalDate {
+	year, month := ym.Date()
+	return LocalDate(mustDate(makeValidDate(year, int(month), day)))
+}
+
+// AtEndOfMonth returns the LocalDate that represents the last day of ym.
+// This function panics if the date cannot be represented.
+func (ym YearMonth) AtEndOfMonth() LocalDate {
+	return ym.AtDay(ym.LengthOfMonth())
+}
+
+// AddDate returns the YearMonth corresponding to adding the given number of years and months to ym.
+// This function panics if the resulting YearMonth cannot be represented.
+func (ym YearMonth) AddDate(years, months int) YearMonth {
+	out, err := addDateToYearMonth(int64(ym), years, months)
+	if err != nil {
+		panic(err.Error())
+	}
+	return YearMonth(out)
+}
+
+// CanAddDate returns false if AddDate would panic if passed the same arguments.
+func (ym YearMonth) CanAddDate(years, months int) bool {
+	_, err := addDateToYearMonth(int64(ym), years, months)
+	return err == nil
+}
+
+// AddDateChecked returns the YearMonth corresponding to adding the given number of years and months to ym.
+// Instead of panicking, it returns an error matching ErrOutOfRange if the resulting YearMonth cannot be represented.
+func (ym YearMonth) AddDateChecked(years, months int) (YearMonth, error) {
+	out, err := addDateToYearMonth(int64(ym), years, months)
+	if err != nil {
+		return 0, err
+	}
+	return YearMonth(out), nil
+}
+
+func (ym YearMonth) String() string {
+	year, month := ym.Date()
+	return fmt.Sprintf("%04d-%02d", year, month)
+}
+
+// Format returns a textual representation of ym formatted according to the layout defined by the argument,
+// such as ISO8601DateTruncated. See the constants section of the documentation to see how to represent the layout format.
+// Day specifiers are formatted as if for the first day of the month that can be represented.
+// Time format specifiers encountered in the layout results in a panic.
+func (ym YearMonth) Format(layout string) string {
+	date := int32(yearMonthToDate(int64(ym)))
+	out, err := formatDateTimeOffset(layout, &date, nil, nil)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// Parse a formatted string and store the value it represents in ym.
+// See the constants section of the documentation to see how to represent the layout format.
+// Any day parsed from the value is discarded. Time format specifiers encountered in the layout results in a panic.
+func (ym *YearMonth) Parse(layout, value string) error {
+	v := yearMonthToDate(int64(*ym))
+	if err := parseDateAndTime(layout, value, &v, nil, nil); err != nil {
+		return err
+	}
+
+	year, month, _, err := fromDate(v)
+	if err != nil {
+		return err
+	}
+
+	out, err := makeYearMonth(year, month)
+	if err != nil {
+		return err
+	}
+
+	*ym = YearMonth(out)
+	return nil
+}
+
+// MinYearMonth returns the earliest supported YearMonth.
+func MinYearMonth() YearMonth {
+	return YearMonth(minYearMonth)
+}
+
+// MaxYearMonth returns the latest supported YearMonth.
+func MaxYearMonth() YearMonth {
+	return YearMonth(maxYearMonth)
+}
+
+const (
+	minYearMonth = int64((minYear-1970)*12 + minMonth - 1)
+	maxYearMonth = int64((maxYear-1970)*12 + maxMonth - 1)
+)
+
+func makeYearMonth(year, month int) (int64, error) {
+	if month < int(January) || month > int(December) {
+		return 0, errInvalidDate("invalid month")
+	}
+
+	out := (int64(year)-1970)*12 + int64(month) - 1
+	if out < minYearMonth || out > maxYearMonth {
+		return 0, errOutOfRange("year-month out of bounds")
+	}
+	return out, nil
+}
+
+func fromYearMonth(v int64) (year, month int) {
+	years := floorDiv(v, 12)
+	return int(1970 + years), int(v-years*12) + 1
+}
+
+func addDateToYearMonth(v int64, years, months int) (int64, error) {
+	year, month := fromYearMonth(v)
+	y, m := normalizeMonth(int64(year)+int64(years), int64(month)+int64(months))
+	if y < minYear || y > maxYear {
+		return 0, errOutOfRange("year-month out of bounds")
+	}
+	return makeYearMonth(int(y), int(m))
+}
+
+// yearMonthToDate returns the first date of the month v that can be represented, for use in formatting and parsing.
+func yearMonthToDate(v int64) int64 {
+	year, month := fromYearMonth(v)
+	if out := makeJDN(int64(year), int64(month), 1); out > minJDN {
+		return out
+	}
+	return minJDN
+}
@@ -0,0 +1,207 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestYearMonth(t *testing.T) {
+	for _, tt := range []struct {
+		year        int
+		month       chrono.Month
+		isLeapYear  bool
+		length      int
+		endOfMonth  chrono.LocalDate
+		str         string
+		ymdDistance int
+	}{
+		{1970, chrono.January, false, 31, chrono.LocalDateOf(1970, chrono.January, 31), "1970-01", 0},
+		{2020, chrono.February, true, 29, chrono.LocalDateOf(2020, chrono.February, 29), "2020-02", 601},
+		{2021, chrono.February, false, 28, chrono.LocalDateOf(2021, chrono.February, 28), "2021-02", 613},
+		{1969, chrono.December, false, 31, chrono.LocalDateOf(1969, chrono.December, 31), "1969-12", -1},
+		{5874898, chrono.June, false, 30, 0, "5874898-06", 70475141},
+	} {
+		t.Run(tt.str, func(t *testing.T) {
+			ym := chrono.YearMonthOf(tt.year, tt.month)
+
+			if year, month := ym.Date(); year != tt.year || month != tt.month {
+				t.Errorf("ym.Date() = %d, %s, want %d, %s", year, month, tt.year, tt.month)
+			}
+
+			if year := ym.Year(); year != tt.year {
+				t.Errorf("ym.Year() = %d, want %d", year, tt.year)
+			}
+
+			if month := ym.Month(); month != tt.month {
+				t.Errorf("ym.Month() = %s, want %s", month, tt.month)
+			}
+
+			if isLeapYear := ym.IsLeapYear(); isLeapYear != tt.isLeapYear {
+				t.Errorf("ym.IsLeapYear() = %t, want %t", isLeapYear, tt.isLeapYear)
+			}
+
+			if length := ym.LengthOfMonth(); length != tt.length {
+				t.Errorf("ym.LengthOfMonth() = %d, want %d", length, tt.length)
+			}
+
+			if tt.endOfMonth != 0 {
+				if d := ym.AtEndOfMonth(); d != tt.endOfMonth {
+					t.Errorf("ym.AtEndOfMonth() = %s, want %s", d, tt.endOfMonth)
+				}
+			}
+
+			if d, expected := ym.AtDay(1), chrono.LocalDateOf(tt.year, tt.month, 1); d != expected {
+				t.Errorf("ym.AtDay(1) = %s, want %s", d, expected)
+			}
+
+			if str := ym.String(); str != tt.str {
+				t.Errorf("ym.String() = %s, want %s", str, tt.str)
+			}
+
+			if d := int(ym - chrono.YearMonthOf(1970, chrono.January)); d != tt.ymdDistance {
+				t.Errorf("ym - 1970-01 = %d, want %d", d, tt.ymdDistance)
+			}
+		})
+	}
+
+	t.Run("zero value", func(t *testing.T) {
+		var ym chrono.YearMonth
+		if expected := chrono.YearMonthOf(1970, chrono.January); ym != expected {
+			t.Errorf("zero value = %s, want %s", ym, expected)
+		}
+	})
+
+	t.Run("ordering", func(t *testing.T) {
+		if !(chrono.YearMonthOf(2020, chrono.December) < chrono.YearMonthOf(2021, chrono.January)) {
+			t.Error("expecting 2020-12 to be before 2021-01")
+		}
+	})
+}
+
+func TestNewYearMonth(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		year  int
+		month chrono.Month
+		err   error
+	}{
+		{"valid", 2021, chrono.March, nil},
+		{"min", -4713, chrono.November, nil},
+		{"max", 5874898, chrono.June, nil},
+		{"invalid month", 2021, 13, chrono.ErrInvalidDate},
+		{"zero month", 2021, 0, chrono.ErrInvalidDate},
+		{"before min", -4713, chrono.October, chrono.ErrOutOfRange},
+		{"after max", 5874898, chrono.July, chrono.ErrOutOfRange},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := chrono.NewYearMonth(tt.year, tt.month)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("NewYearMonth() = %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	if ym := chrono.MinYearMonth(); ym.String() != "-4713-11" {
+		t.Errorf("MinYearMonth() = %s, want -4713-11", ym)
+	}
+
+	if ym := chrono.MaxYearMonth(); ym.String() != "5874898-06" {
+		t.Errorf("MaxYearMonth() = %s, want 5874898-06", ym)
+	}
+}
+
+func TestYearMonth_AtDay(t *testing.T) {
+	ym := chrono.YearMonthOf(2021, chrono.February)
+	if d, expected := ym.AtDay(28), chrono.LocalDateOf(2021, chrono.February, 28); d != expected {
+		t.Errorf("ym.AtDay(28) = %s, want %s", d, expected)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expecting panic for 29th February 2021")
+			}
+		}()
+		ym.AtDay(29)
+	}()
+}
+
+func TestYearMonth_AddDate(t *testing.T) {
+	for _, tt := range []struct {
+		ym       chrono.YearMonth
+		years    int
+		months   int
+		expected chrono.YearMonth
+	}{
+		{chrono.YearMonthOf(2021, chrono.January), 0, 1, chrono.YearMonthOf(2021, chrono.February)},
+		{chrono.YearMonthOf(2021, chrono.November), 0, 3, chrono.YearMonthOf(2022, chrono.February)},
+		{chrono.YearMonthOf(2021, chrono.January), 0, -1, chrono.YearMonthOf(2020, chrono.December)},
+		{chrono.YearMonthOf(2021, chrono.January), -1, 25, chrono.YearMonthOf(2022, chrono.February)},
+		{chrono.YearMonthOf(2021, chrono.March), 2, -14, chrono.YearMonthOf(2022, chrono.January)},
+	} {
+		t.Run(tt.ym.String(), func(t *testing.T) {
+			if ok := tt.ym.CanAddDate(tt.years, tt.months); !ok {
+				t.Errorf("ym.CanAddDate(%d, %d) = false, want true", tt.years, tt.months)
+			}
+
+			if out := tt.ym.AddDate(tt.years, tt.months); out != tt.expected {
+				t.Errorf("ym.AddDate(%d, %d) = %s, want %s", tt.years, tt.months, out, tt.expected)
+			}
+		})
+	}
+
+	t.Run("overflow", func(t *testing.T) {
+		if _, err := chrono.MaxYearMonth().AddDateChecked(0, 1); !errors.Is(err, chrono.ErrOutOfRange) {
+			t.Errorf("MaxYearMonth().AddDateChecked(0, 1) = %v, want %v", err, chrono.ErrOutOfRange)
+		}
+
+		if chrono.MinYearMonth().CanAddDate(0, -1) {
+			t.Error("MinYearMonth().CanAddDate(0, -1) = true, want false")
+		}
+	})
+}
+
+func TestYearMonth_Format(t *testing.T) {
+	ym := chrono.YearMonthOf(2006, chrono.January)
+	if out := ym.Format(chrono.ISO8601DateTruncated); out != "2006-01" {
+		t.Errorf("ym.Format() = %s, want 2006-01", out)
+	}
+
+	if out := ym.Format("%B %Y"); out != "January 2006" {
+		t.Errorf("ym.Format() = %s, want January 2006", out)
+	}
+
+	if out := chrono.MinYearMonth().Format(chrono.ISO8601DateTruncated); out != "-4713-11" {
+		t.Errorf("MinYearMonth().Format() = %s, want -4713-11", out)
+	}
+}
+
+func TestYearMonth_Parse(t *testing.T) {
+	for _, tt := range []struct {
+		layout   string
+		value    string
+		expected chrono.YearMonth
+	}{
+		{chrono.ISO8601DateTruncated, "2006-01", chrono.YearMonthOf(2006, chrono.January)},
+		{"%m/%Y", "12/2021", chrono.YearMonthOf(2021, chrono.December)},
+		{chrono.ISO8601DateExtended, "2020-02-29", chrono.YearMonthOf(2020, chrono.February)},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			var ym chrono.YearMonth
+			if err := ym.Parse(tt.layout, tt.value); err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+
+			if ym != tt.expected {
+				t.Errorf("ym.Parse() = %s, want %s", ym, tt.expected)
+			}
+		})
+	}
+
+	var ym chrono.YearMonth
+	if err := ym.Parse(chrono.ISO8601DateTruncated, "2006-13"); err == nil {
+		t.Error("expecting error")
+	}
+}
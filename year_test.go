@@ -0,0 +1,103 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestYear(t *testing.T) {
+	for _, tt := range []struct {
+		year    chrono.Year
+		isLeap  bool
+		length  int
+		lastDay chrono.LocalDate
+		str     string
+	}{
+		{2020, true, 366, chrono.LocalDateOf(2020, chrono.December, 31), "2020"},
+		{2021, false, 365, chrono.LocalDateOf(2021, chrono.December, 31), "2021"},
+		{1900, false, 365, chrono.LocalDateOf(1900, chrono.December, 31), "1900"},
+		{2000, true, 366, chrono.LocalDateOf(2000, chrono.December, 31), "2000"},
+		{-1, false, 365, chrono.LocalDateOf(-1, chrono.December, 31), "-001"},
+	} {
+		t.Run(tt.str, func(t *testing.T) {
+			if isLeap := tt.year.IsLeap(); isLeap != tt.isLeap {
+				t.Errorf("y.IsLeap() = %t, want %t", isLeap, tt.isLeap)
+			}
+
+			if length := tt.year.Length(); length != tt.length {
+				t.Errorf("y.Length() = %d, want %d", length, tt.length)
+			}
+
+			if d := tt.year.AtDay(tt.length); d != tt.lastDay {
+				t.Errorf("y.AtDay(%d) = %s, want %s", tt.length, d, tt.lastDay)
+			}
+
+			if str := tt.year.String(); str != tt.str {
+				t.Errorf("y.String() = %s, want %s", str, tt.str)
+			}
+		})
+	}
+}
+
+func TestYear_At(t *testing.T) {
+	y := chrono.Year(2021)
+	if ym, expected := y.AtMonth(chrono.February), chrono.YearMonthOf(2021, chrono.February); ym != expected {
+		t.Errorf("y.AtMonth() = %s, want %s", ym, expected)
+	}
+
+	if d, expected := y.AtMonthDay(chrono.MonthDayOf(chrono.February, 29)), chrono.LocalDateOf(2021, chrono.February, 28); d != expected {
+		t.Errorf("y.AtMonthDay() = %s, want %s", d, expected)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expecting panic for day 366 of 2021")
+			}
+		}()
+		y.AtDay(366)
+	}()
+}
+
+func TestYear_Format(t *testing.T) {
+	if out := chrono.Year(2006).Format("%Y"); out != "2006" {
+		t.Errorf("y.Format() = %s, want 2006", out)
+	}
+
+	if out := chrono.MinYear().Format("%Y"); out != "-4713" {
+		t.Errorf("MinYear().Format() = %s, want -4713", out)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expecting panic for unrepresentable year")
+			}
+		}()
+		(chrono.MaxYear() + 1).Format("%Y")
+	}()
+}
+
+func TestYear_Parse(t *testing.T) {
+	for _, tt := range []struct {
+		layout   string
+		value    string
+		expected chrono.Year
+	}{
+		{"%Y", "2006", 2006},
+		{chrono.ISO8601DateExtended, "2020-02-29", 2020},
+		{"%Y", "-0001", -1},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			var y chrono.Year
+			if err := y.Parse(tt.layout, tt.value); err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+
+			if y != tt.expected {
+				t.Errorf("y.Parse() = %s, want %s", y, tt.expected)
+			}
+		})
+	}
+}